@@ -4,13 +4,14 @@ import (
 	"fmt"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // CivoInstanceDestroy is used to destroy the instance created during the test
 func CivoInstanceDestroy(s *terraform.State) error {
-	client := TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_instance" {
@@ -36,7 +37,7 @@ func CivoInstanceResourceExists(n string, instance *civogo.Instance) resource.Te
 		}
 
 		// retrieve the configured client from the test setup
-		client := TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(TestAccProvider.Meta())
 		resp, err := client.GetInstance(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("instance not found: (%s) %s", rs.Primary.ID, err)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -18,7 +19,7 @@ func CivoReservedIPResourceExists(n string, ip *civogo.IP) resource.TestCheckFun
 		}
 
 		// retrieve the configured client from the test setup
-		client := TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(TestAccProvider.Meta())
 		resp, err := client.FindIP(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ip not found: (%s) %s", rs.Primary.ID, err)
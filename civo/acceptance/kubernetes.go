@@ -3,13 +3,13 @@ package acceptance
 import (
 	"fmt"
 
-	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // CivoKubernetesClusterDestroy is used to destroy the kubernetes cluster created during the test
 func CivoKubernetesClusterDestroy(s *terraform.State) error {
-	client := TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_kubernetes_cluster" {
@@ -0,0 +1,70 @@
+package civo
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultCredentialsFile is the config file written by the civo CLI
+const defaultCredentialsFile = "~/.civo.json"
+
+// cliConfig is the part of the civo CLI config file the provider reads
+type cliConfig struct {
+	APIKeys map[string]string `json:"apikeys"`
+	Meta    struct {
+		CurrentAPIKey string `json:"current_apikey"`
+		DefaultRegion string `json:"default_region"`
+	} `json:"meta"`
+}
+
+// cliCredentials are the token and region of the API key currently selected in the civo CLI
+type cliCredentials struct {
+	Token  string
+	Region string
+}
+
+// readCredentialsFile reads the token and region the civo CLI is using from its config file.
+// When path is empty the default CLI config file is used, and it's not an error if it doesn't exist.
+func readCredentialsFile(path string) (*cliCredentials, error) {
+	explicit := path != ""
+	if !explicit {
+		path = defaultCredentialsFile
+	}
+
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("[ERR] unable to find the home directory to read %s: %s", path, err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return &cliCredentials{}, nil
+		}
+		return nil, fmt.Errorf("[ERR] unable to read the credentials file %s: %s", path, err)
+	}
+
+	config := cliConfig{}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("[ERR] unable to parse the credentials file %s: %s", path, err)
+	}
+
+	credentials := &cliCredentials{
+		Region: config.Meta.DefaultRegion,
+	}
+	if config.Meta.CurrentAPIKey != "" {
+		token, ok := config.APIKeys[config.Meta.CurrentAPIKey]
+		if !ok {
+			return nil, fmt.Errorf("[ERR] the current API key %q was not found in the credentials file %s", config.Meta.CurrentAPIKey, path)
+		}
+		credentials.Token = token
+	}
+
+	return credentials, nil
+}
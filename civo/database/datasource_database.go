@@ -3,10 +3,11 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -103,18 +104,13 @@ func DataSourceDatabase() *schema.Resource {
 	}
 }
 
-func dataSourceDatabaseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func dataSourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	var foundDatabase *civogo.Database
 
 	if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the Database by name")
+		tflog.Info(ctx, "Getting the Database by name")
 		database, err := apiClient.FindDatabase(name.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive Database: %s", err)
@@ -124,7 +120,7 @@ func dataSourceDatabaseRead(_ context.Context, d *schema.ResourceData, m interfa
 	}
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the Database by id")
+		tflog.Info(ctx, "Getting the Database by id")
 		database, err := apiClient.FindDatabase(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive Database: %s", err)
@@ -2,8 +2,9 @@ package database
 
 import (
 	"fmt"
-	"github.com/civo/civogo"
+
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,8 +19,15 @@ type Version struct {
 // use to define the engine and version in resourceDatabase
 func DataDatabaseVersion() *schema.Resource {
 	dataListConfig := &datalist.ResourceConfig{
-		Description:         "Retrieves information about the database versions that Civo supports, with the ability to filter the results.",
-		RecordSchema:        versionSchema(),
+		Description:  "Retrieves information about the database versions that Civo supports, with the ability to filter the results.",
+		RecordSchema: versionSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all versions will be from the provided region",
+			},
+		},
 		ResultAttributeName: "versions",
 		FlattenRecord:       flattenVersion,
 		GetRecords:          getVersion,
@@ -28,11 +36,17 @@ func DataDatabaseVersion() *schema.Resource {
 	return datalist.NewResource(dataListConfig)
 }
 
-func getVersion(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+func getVersion(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
 
 	versions := []interface{}{}
-	partialVersions, err := apiClient.ListDBVersions()
+	partialVersions, err := utils.Cached(m, "database_versions/"+apiClient.Region, apiClient.ListDBVersions)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving version: %s", err)
 	}
@@ -3,10 +3,11 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -98,6 +99,7 @@ func ResourceDatabase() *schema.Resource {
 				Computed:    true,
 				Description: "The status of the database",
 			},
+			"deletion_protection": utils.DeletionProtectionSchema(),
 		},
 		CreateContext: resourceDatabaseCreate,
 		ReadContext:   resourceDatabaseRead,
@@ -116,14 +118,9 @@ func ResourceDatabase() *schema.Resource {
 
 // function to create a database
 func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] configuring the database %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("configuring the database %s", d.Get("name").(string)))
 
 	config := &civogo.CreateDatabaseRequest{
 		Name:            d.Get("name").(string),
@@ -164,7 +161,7 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 		config.FirewallID = firewallID
 	}
 
-	log.Printf("[INFO] creating the Database %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("creating the Database %s", d.Get("name").(string)))
 	database, err := apiClient.NewDatabase(config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create Database: %s", err)
@@ -182,7 +179,7 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 60,
@@ -197,11 +194,11 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 // Function to Update the database
 func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	// nothing to update in the API when only the deletion protection changed
+	if !d.HasChanges("nodes", "name", "firewall_id") {
+		return resourceDatabaseRead(ctx, d, m)
 	}
 
 	_, err := apiClient.FindDatabase(d.Id())
@@ -228,7 +225,7 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		config.FirewallID = firewallID
 	}
 
-	log.Printf("[INFO] updating the Database %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("updating the Database %s", d.Id()))
 	_, err = apiClient.UpdateDatabase(d.Id(), config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to update Database: %s", err)
@@ -239,19 +236,13 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 // Function to Read the database
 func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retriving the Database %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the Database %s", d.Id()))
 	resp, err := apiClient.GetDatabase(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "database")
 		}
 		return diag.Errorf("[ERR] failed to retrive the Database: %s", err)
 	}
@@ -276,14 +267,13 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 // Function to delete the database
 func resourceDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	if diags := utils.CheckDeletionProtection(d, "database"); diags != nil {
+		return diags
 	}
 
-	log.Printf("[INFO] deleting the Database %s", d.Id())
+	apiClient := utils.ResourceClient(m, d)
+
+	tflog.Info(ctx, fmt.Sprintf("deleting the Database %s", d.Id()))
 	_, err := apiClient.DeleteDatabase(d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the Database %s", d.Id())
@@ -3,17 +3,20 @@ package disk
 import (
 	"fmt"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // TemplateDisk is a temporal struct to get all template in one place
 type TemplateDisk struct {
-	ID      string
-	Name    string
-	Version string
-	Label   string
+	ID           string
+	Name         string
+	Version      string
+	Label        string
+	Distribution string
+	State        string
+	Description  string
 }
 
 // DataSourceDiskImage Data source to get from the api a specific template
@@ -39,27 +42,31 @@ func DataSourceDiskImage() *schema.Resource {
 }
 
 func getDiskimages(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
-
 	// overwrite the region if is define in the datasource
 	region, ok := extra["region"].(string)
 	if !ok {
 		return nil, fmt.Errorf("unable to find `region` key from query data")
 	}
 
-	if region != "" {
-		apiClient.Region = region
-	}
+	apiClient := utils.ClientForRegion(m, region)
 
 	templateDiskList := []TemplateDisk{}
 
-	diskImage, err := apiClient.ListDiskImages()
+	diskImage, err := utils.Cached(m, "disk_images/"+apiClient.Region, apiClient.ListDiskImages)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving all Disk Images: %s", err)
 	}
 
 	for _, v := range diskImage {
-		templateDiskList = append(templateDiskList, TemplateDisk{ID: v.ID, Name: v.Name, Version: v.Version, Label: v.Label})
+		templateDiskList = append(templateDiskList, TemplateDisk{
+			ID:           v.ID,
+			Name:         v.Name,
+			Version:      v.Version,
+			Label:        v.Label,
+			Distribution: v.Distribution,
+			State:        v.State,
+			Description:  v.Description,
+		})
 	}
 
 	templates := []interface{}{}
@@ -79,6 +86,9 @@ func flattenDiskimage(template, _ interface{}, _ map[string]interface{}) (map[st
 	flattenedTemplate["name"] = s.Name
 	flattenedTemplate["version"] = s.Version
 	flattenedTemplate["label"] = s.Label
+	flattenedTemplate["distribution"] = s.Distribution
+	flattenedTemplate["state"] = s.State
+	flattenedTemplate["description"] = s.Description
 
 	return flattenedTemplate, nil
 }
@@ -106,5 +116,20 @@ func diskimageSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "Label of disk image",
 		},
+		"distribution": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "OS family of disk image, e.g. ubuntu or debian",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of disk image",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of disk image",
+		},
 	}
 }
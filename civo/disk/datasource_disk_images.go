@@ -0,0 +1,32 @@
+package disk
+
+import (
+	"strings"
+
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceDiskImages Data source to get and filter all the disk images of a region,
+// for example by name regex, distribution or version
+func DataSourceDiskImages() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: diskimageSchema(),
+		Description: strings.Join([]string{
+			"Get information on the disk images of a region, with the ability to filter and sort the results by name, distribution or version. If no filters are specified, all disk images will be returned.",
+			"Note: You can pass the `id` or the `name` of a disk image to the `disk_image` argument of `civo_instance`.",
+		}, "\n\n"),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If is used, all disk image will be from this region. Required if no region is set in provider.",
+			},
+		},
+		ResultAttributeName: "disk_images",
+		FlattenRecord:       flattenDiskimage,
+		GetRecords:          getDiskimages,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
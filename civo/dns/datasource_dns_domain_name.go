@@ -2,10 +2,11 @@ package dns
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,13 +39,13 @@ func DataSourceDNSDomainName() *schema.Resource {
 	}
 }
 
-func dataSourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func dataSourceDNSDomainNameRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
 	var foundDomain *civogo.DNSDomain
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the domain by id")
+		tflog.Info(ctx, "Getting the domain by id")
 		domain, err := apiClient.FindDNSDomain(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive domain: %s", err)
@@ -52,7 +53,7 @@ func dataSourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m in
 
 		foundDomain = domain
 	} else if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the domain by name")
+		tflog.Info(ctx, "Getting the domain by name")
 		image, err := apiClient.FindDNSDomain(name.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive domain: %s", err)
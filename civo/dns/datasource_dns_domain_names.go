@@ -0,0 +1,92 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// domainRecordsCount is a domain together with the number of its records
+type domainRecordsCount struct {
+	civogo.DNSDomain
+	RecordsCount int
+}
+
+// DataSourceDNSDomainNames Data source to get and filter all the domains of the account
+func DataSourceDNSDomainNames() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on all the domains of your Civo account, with the ability to filter and sort the results. If no filters are specified, all domains will be returned.",
+			"The records of every domain can be read with the `civo_dns_domain_records` data source, for example to check every domain has SPF and DMARC records.",
+			"Note: You can use the `civo_dns_domain_name` data source to obtain metadata about a single domain if you already know the id or name to retrieve.",
+		}, "\n\n"),
+		RecordSchema:        dnsDomainNamesSchema(),
+		ResultAttributeName: "domains",
+		FlattenRecord:       flattenDataSourceDNSDomainNames,
+		GetRecords:          getDataSourceDNSDomainNames,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceDNSDomainNames(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
+	apiClient := utils.ProviderClient(m)
+
+	allDomains, err := apiClient.ListDNSDomains()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the domains: %s", err)
+	}
+
+	// the domains don't have the number of their records, it's read from the records of every domain
+	var domains []interface{}
+	for _, domain := range allDomains {
+		records, err := apiClient.ListDNSRecords(domain.ID)
+		if err != nil {
+			return nil, fmt.Errorf("[ERR] error retrieving the records of the domain %s: %s", domain.Name, err)
+		}
+
+		domains = append(domains, domainRecordsCount{
+			DNSDomain:    domain,
+			RecordsCount: len(records),
+		})
+	}
+
+	return domains, nil
+}
+
+func flattenDataSourceDNSDomainNames(domain, _ interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+	dn := domain.(domainRecordsCount)
+
+	flattenedDomain := map[string]interface{}{}
+	flattenedDomain["id"] = dn.ID
+	flattenedDomain["name"] = dn.Name
+	flattenedDomain["account_id"] = dn.AccountID
+	flattenedDomain["records_count"] = dn.RecordsCount
+
+	return flattenedDomain, nil
+}
+
+func dnsDomainNamesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the domain",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the domain",
+		},
+		"account_id": {
+			Type:        schema.TypeString,
+			Description: "The ID account of the domain",
+		},
+		"records_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of records of the domain",
+		},
+	}
+}
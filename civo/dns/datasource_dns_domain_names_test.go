@@ -0,0 +1,56 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceCivoDNSDomainNames_basic is a basic test case for the DNS domain names data source.
+func TestAccDataSourceCivoDNSDomainNames_basic(t *testing.T) {
+	datasourceName := "data.civo_dns_domain_names.domains"
+	domain := acctest.RandomWithPrefix("domains") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoDNSDomainNamesConfig(domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "domains.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "domains.0.name", domain),
+					resource.TestCheckResourceAttr(datasourceName, "domains.0.records_count", "1"),
+					resource.TestCheckResourceAttrPair(datasourceName, "domains.0.id", "civo_dns_domain_name.domain", "id"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoDNSDomainNamesConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "domain" {
+	name = "%[1]s"
+}
+
+resource "civo_dns_domain_record" "www" {
+	domain_id = civo_dns_domain_name.domain.id
+    type = "A"
+    name = "www"
+    value = "192.168.1.1"
+    ttl = 600
+}
+
+data "civo_dns_domain_names" "domains" {
+	filter {
+		key = "name"
+		values = [civo_dns_domain_name.domain.name]
+	}
+	depends_on = [civo_dns_domain_record.www]
+}
+`, domain)
+}
@@ -0,0 +1,128 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceDNSDomainRecords Data source to get and filter all the records of a domain
+func DataSourceDNSDomainRecords() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on all the records of a domain, with the ability to filter and sort the results. If no filters are specified, all the records of the domain will be returned.",
+			"This can be used to reference the records of a domain managed in another workspace, or to audit the records of a domain.",
+			"Note: You can use the `civo_dns_domain_record` data source to obtain metadata about a single record if you already know its name.",
+		}, "\n\n"),
+		RecordSchema: dnsDomainRecordsSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the domain",
+			},
+		},
+		ResultAttributeName: "records",
+		FlattenRecord:       flattenDataSourceDNSDomainRecords,
+		GetRecords:          getDataSourceDNSDomainRecords,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceDNSDomainRecords(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	domainID, ok := extra["domain_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `domain_id` key from query data")
+	}
+
+	apiClient := utils.ProviderClient(m)
+
+	allRecords, err := apiClient.ListDNSRecords(domainID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the records of the domain %s: %s", domainID, err)
+	}
+
+	domainName, err := dnsDomainName(apiClient, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []interface{}
+	for _, record := range allRecords {
+		// the names are relative to the domain, as they're declared
+		record.Name = normalizeRecordName(record.Name, domainName)
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func flattenDataSourceDNSDomainRecords(record, _ interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+	r := record.(civogo.DNSRecord)
+
+	flattenedRecord := map[string]interface{}{}
+	flattenedRecord["id"] = r.ID
+	flattenedRecord["domain_id"] = r.DNSDomainID
+	flattenedRecord["name"] = r.Name
+	flattenedRecord["type"] = strings.ToUpper(string(r.Type))
+	flattenedRecord["value"] = r.Value
+	flattenedRecord["priority"] = r.Priority
+	flattenedRecord["ttl"] = r.TTL
+	flattenedRecord["account_id"] = r.AccountID
+	flattenedRecord["created_at"] = r.CreatedAt.UTC().String()
+	flattenedRecord["updated_at"] = r.UpdatedAt.UTC().String()
+
+	return flattenedRecord, nil
+}
+
+func dnsDomainRecordsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the record",
+		},
+		"domain_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the domain of the record",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the record, @ for the apex/root domain",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "The record type, one of A, CNAME, MX, SRV or TXT",
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Description: "The IP address (A or MX), hostname (CNAME or MX), weight, port and target (SRV) or text value (TXT) served for this record",
+		},
+		"priority": {
+			Type:        schema.TypeInt,
+			Description: "The priority of the record, for MX and SRV records",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Description: "How long caching DNS servers should cache this record",
+		},
+		"account_id": {
+			Type:        schema.TypeString,
+			Description: "The ID account of the record",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date when it was created in UTC format",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "The date when it was updated in UTC format",
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccDataSourceCivoDNSDomainRecords_basic is a basic test case for the DNS domain records data source.
+func TestAccDataSourceCivoDNSDomainRecords_basic(t *testing.T) {
+	datasourceName := "data.civo_dns_domain_records.records"
+	domain := acctest.RandomWithPrefix("recordstest") + ".com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoDNSDomainRecordsConfigBasic(domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "records.0.name", "api"),
+					resource.TestCheckResourceAttr(datasourceName, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(datasourceName, "records.0.value", "192.168.1.2"),
+					resource.TestCheckResourceAttr(datasourceName, "records.1.name", "www"),
+					resource.TestCheckResourceAttr(datasourceName, "records.1.ttl", "600"),
+					resource.TestCheckResourceAttrSet(datasourceName, "records.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoDNSDomainRecordsConfigBasic(domain string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "domain" {
+	name = "%[1]s"
+}
+
+resource "civo_dns_domain_record" "www" {
+	domain_id = civo_dns_domain_name.domain.id
+    type = "A"
+    name = "www"
+    value = "192.168.1.1"
+    ttl = 600
+}
+
+resource "civo_dns_domain_record" "api" {
+	domain_id = civo_dns_domain_name.domain.id
+    type = "A"
+    name = "api"
+    value = "192.168.1.2"
+    ttl = 600
+}
+
+data "civo_dns_domain_records" "records" {
+	domain_id = civo_dns_domain_name.domain.id
+	sort {
+		key = "name"
+	}
+	depends_on = [civo_dns_domain_record.www, civo_dns_domain_record.api]
+}
+`, domain)
+}
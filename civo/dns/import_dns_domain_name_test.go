@@ -32,3 +32,25 @@ func TestAccCivoDNSDomainName_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCivoDNSDomainName_importByID(t *testing.T) {
+	resourceName := "civo_dns_domain_name.foobar"
+	domainName := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoDNSDomainNameConfigBasic(domainName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
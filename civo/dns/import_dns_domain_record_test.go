@@ -33,6 +33,35 @@ func TestAccCivoDNSDomainRecord_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccCivoDNSDomainRecord_importByName(t *testing.T) {
+	resourceName := "civo_dns_domain_record.www"
+	var domainName = acctest.RandomWithPrefix("tf-test-record") + ".example"
+	var recordName = acctest.RandomWithPrefix("record")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoDNSDomainNameRecordConfigBasic(domainName, recordName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/A/%s", domainName, recordName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/a/%s/10.10.10.1", domainName, recordName),
+			},
+		},
+	})
+}
+
 func DNSDomainNameRecordImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
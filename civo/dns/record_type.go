@@ -0,0 +1,158 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// srvNameRegexp matches the names of SRV records, the service and the protocol prefixed by an underscore
+var srvNameRegexp = regexp.MustCompile(`^_[a-zA-Z0-9-]+\._[a-zA-Z0-9-]+(\..+)?$`)
+
+// normalizeRecordName returns the name of the record relative to its domain, as it's declared: `@` for the
+// apex, which the API can return empty or as the domain itself, and `*` or `*.sub` for the wildcards, which the
+// API can return qualified by the domain
+func normalizeRecordName(name, domainName string) string {
+	name = strings.TrimSuffix(name, ".")
+	domainName = strings.TrimSuffix(domainName, ".")
+
+	switch {
+	case name == "" || name == "@":
+		return "@"
+	case domainName != "" && strings.EqualFold(name, domainName):
+		return "@"
+	case domainName != "" && len(name) > len(domainName)+1 && strings.EqualFold(name[len(name)-len(domainName)-1:], "."+domainName):
+		return name[:len(name)-len(domainName)-1]
+	}
+
+	return name
+}
+
+// recordNameNeedsDomain reports whether the domain name is needed to normalize the name of the record, which
+// is only the case of the names that can be qualified by the domain
+func recordNameNeedsDomain(name string) bool {
+	return strings.Contains(strings.TrimSuffix(name, "."), ".")
+}
+
+// dnsDomainName returns the name of the domain, to normalize the names of its records
+func dnsDomainName(apiClient *civogo.Client, domainID string) (string, error) {
+	domain, err := apiClient.FindDNSDomain(domainID)
+	if err != nil {
+		return "", fmt.Errorf("[ERR] failed to retrieve the domain %s: %s", domainID, err)
+	}
+
+	return domain.Name, nil
+}
+
+// srvRecordValue returns the value of an SRV record as the API stores it, the priority is sent apart
+func srvRecordValue(weight, port int, target string) string {
+	return fmt.Sprintf("%d %d %s", weight, port, target)
+}
+
+// parseSRVRecordValue splits the value of an SRV record into its weight, port and target
+func parseSRVRecordValue(value string) (weight, port int, target string, ok bool) {
+	fields := strings.Fields(value)
+	if len(fields) != 3 {
+		return 0, 0, "", false
+	}
+
+	weight, errWeight := strconv.Atoi(fields[0])
+	port, errPort := strconv.Atoi(fields[1])
+	if errWeight != nil || errPort != nil {
+		return 0, 0, "", false
+	}
+
+	return weight, port, fields[2], true
+}
+
+// expandDNSRecordConfig builds the record to send to the API, the weight and the port of SRV records are
+// part of their value
+func expandDNSRecordConfig(d *schema.ResourceData) *civogo.DNSRecordConfig {
+	config := &civogo.DNSRecordConfig{
+		Type:     civogo.DNSRecordType(d.Get("type").(string)),
+		Name:     d.Get("name").(string),
+		Value:    d.Get("value").(string),
+		Priority: d.Get("priority").(int),
+		TTL:      d.Get("ttl").(int),
+	}
+
+	if config.Type == civogo.DNSRecordTypeSRV && d.Get("port").(int) != 0 {
+		config.Value = srvRecordValue(d.Get("weight").(int), d.Get("port").(int), config.Value)
+	}
+
+	return config
+}
+
+// flattenDNSRecordValue sets the value of the record, the value of SRV records is split into the weight, the
+// port and the target when the port is declared apart
+func flattenDNSRecordValue(d *schema.ResourceData, record *civogo.DNSRecord) {
+	if strings.EqualFold(string(record.Type), civogo.DNSRecordTypeSRV) && d.Get("port").(int) != 0 {
+		if weight, port, target, ok := parseSRVRecordValue(record.Value); ok {
+			d.Set("weight", weight)
+			d.Set("port", port)
+			d.Set("value", target)
+			return
+		}
+	}
+
+	d.Set("value", record.Value)
+}
+
+// dnsRecordCustomizeDiff checks the fields of the record are the ones of its type, so a record the API would
+// refuse or serve wrong fails when planning
+func dnsRecordCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	recordType := d.Get("type").(string)
+
+	if d.Get("priority").(int) != 0 && recordType != civogo.DNSRecordTypeMX && recordType != civogo.DNSRecordTypeSRV {
+		return fmt.Errorf("[ERR] `priority` can only be set for MX and SRV records, not %s records", recordType)
+	}
+	if (d.Get("weight").(int) != 0 || d.Get("port").(int) != 0) && recordType != civogo.DNSRecordTypeSRV {
+		return fmt.Errorf("[ERR] `weight` and `port` can only be set for SRV records, not %s records", recordType)
+	}
+
+	// the TTL can come from another resource, so it's checked again once it's known
+	if ttl := d.Get("ttl").(int); d.NewValueKnown("ttl") && (ttl < minRecordTTL || ttl > maxRecordTTL) {
+		return fmt.Errorf("[ERR] the TTL of the record has to be between %d and %d seconds, got: %d", minRecordTTL, maxRecordTTL, ttl)
+	}
+
+	if !d.NewValueKnown("value") || !d.NewValueKnown("name") {
+		return nil
+	}
+	value, name := d.Get("value").(string), d.Get("name").(string)
+
+	// the wildcard can only be the first label of the name
+	if labels := strings.Split(name, "."); slices.Contains(labels[1:], "*") || (strings.Contains(labels[0], "*") && labels[0] != "*") {
+		return fmt.Errorf("[ERR] the wildcard has to be the first label of the name, e.g. * or *.sub, got: %s", name)
+	}
+
+	switch recordType {
+	case civogo.DNSRecordTypeA:
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("[ERR] the value of A records has to be an IPv4 address, got: %s", value)
+		}
+	case civogo.DNSRecordTypeCName:
+		if net.ParseIP(value) != nil {
+			return fmt.Errorf("[ERR] the value of CNAME records has to be a hostname, got the IP address %s, use an A record instead", value)
+		}
+	case civogo.DNSRecordTypeSRV:
+		if !srvNameRegexp.MatchString(name) {
+			return fmt.Errorf("[ERR] the name of SRV records has to start with the service and the protocol, e.g. _sip._tcp, got: %s", name)
+		}
+		// the weight and the port can be part of the value instead, as the API stores them
+		if _, _, _, ok := parseSRVRecordValue(value); d.Get("port").(int) == 0 && !ok {
+			return fmt.Errorf("[ERR] the port of SRV records is required, set `port` and the target host in `value`")
+		}
+	}
+
+	return nil
+}
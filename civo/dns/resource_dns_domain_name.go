@@ -2,10 +2,12 @@ package dns
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"strings"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -34,16 +36,16 @@ func ResourceDNSDomainName() *schema.Resource {
 		DeleteContext: resourceDNSDomainNameDelete,
 		//Exists: resourceExistsItem,
 		Importer: &schema.ResourceImporter{
-			State: resourceDNSDomainImport,
+			StateContext: resourceDNSDomainImport,
 		},
 	}
 }
 
 // function to create a new domain in your account
 func resourceDNSDomainNameCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] Creating the domain %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Creating the domain %s", d.Get("name").(string)))
 	dnsDomain, err := apiClient.CreateDNSDomain(d.Get("name").(string))
 	if err != nil {
 		return diag.Errorf("failed to create a new domains: %s", err)
@@ -55,15 +57,14 @@ func resourceDNSDomainNameCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 // function to read a domain from your account
-func resourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceDNSDomainNameRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] retriving the domain %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("retriving the domain %s", d.Get("name").(string)))
 	resp, err := apiClient.GetDNSDomain(d.Get("name").(string))
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "domain")
 		}
 
 		return diag.Errorf("[ERR] error retrieving domain: %s", err)
@@ -77,19 +78,19 @@ func resourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m inte
 
 // function to update a specific domain
 func resourceDNSDomainNameUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] Searching the domain %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Searching the domain %s", d.Get("name").(string)))
 	resp, err := apiClient.FindDNSDomain(d.Id())
 	if err != nil {
-		log.Printf("[WARN] domain (%s) not found", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("domain (%s) not found", d.Id()))
 		d.SetId("")
 		return nil
 	}
 
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		log.Printf("[INFO] Renaming the domain to %s", d.Get("name").(string))
+		tflog.Info(ctx, fmt.Sprintf("Renaming the domain to %s", d.Get("name").(string)))
 		_, err := apiClient.UpdateDNSDomain(resp, name)
 		if err != nil {
 			return diag.Errorf("[WARN] an error occurred while renamed the domain (%s)", d.Id())
@@ -101,18 +102,18 @@ func resourceDNSDomainNameUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 // function to delete a specific domain
-func resourceDNSDomainNameDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceDNSDomainNameDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] Searching the domain to %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Searching the domain to %s", d.Get("name").(string)))
 	resp, err := apiClient.FindDNSDomain(d.Id())
 	if err != nil {
-		log.Printf("[WARN] domain (%s) not found", d.Id())
+		tflog.Warn(ctx, fmt.Sprintf("domain (%s) not found", d.Id()))
 		d.SetId("")
 		return nil
 	}
 
-	log.Printf("[INFO] Deleting the domain %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Deleting the domain %s", d.Get("name").(string)))
 	_, err = apiClient.DeleteDNSDomain(resp)
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the domain %s", d.Id())
@@ -120,16 +121,14 @@ func resourceDNSDomainNameDelete(_ context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
-// custom import to able add a main domain to the terraform
-func resourceDNSDomainImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
+// custom import to able add a main domain to the terraform, the domain is imported with its name or its ID
+func resourceDNSDomainImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] Searching the domain %s", d.Id())
-	resp, err := apiClient.GetDNSDomain(d.Id())
+	tflog.Debug(ctx, fmt.Sprintf("searching the domain %s", d.Id()))
+	resp, err := findDNSDomainByNameOrID(apiClient, d.Id())
 	if err != nil {
-		if resp != nil {
-			return nil, err
-		}
+		return nil, err
 	}
 
 	d.SetId(resp.ID)
@@ -138,3 +137,21 @@ func resourceDNSDomainImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// findDNSDomainByNameOrID returns the domain with the exact name or ID, unlike FindDNSDomain which also
+// matches part of them
+func findDNSDomainByNameOrID(apiClient *civogo.Client, search string) (*civogo.DNSDomain, error) {
+	domains, err := apiClient.ListDNSDomains()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the domains: %s", err)
+	}
+
+	search = strings.TrimSuffix(search, ".")
+	for _, domain := range domains {
+		if domain.ID == search || strings.EqualFold(domain.Name, search) {
+			return &domain, nil
+		}
+	}
+
+	return nil, fmt.Errorf("[ERR] no domain found with the name or ID %s", search)
+}
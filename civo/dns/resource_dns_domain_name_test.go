@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -94,7 +95,7 @@ func CivoDNSDomainNameResourceExists(n string, domain *civogo.DNSDomain) resourc
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.FindDNSDomain(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Domain not found: (%s) %s", rs.Primary.ID, err)
@@ -118,7 +119,7 @@ func CivoDNSDomainNameUpdated(domain *civogo.DNSDomain, name string) resource.Te
 }
 
 func CivoDNSDomainNameDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_dns_domain_name" {
@@ -2,11 +2,12 @@ package dns
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,12 +21,13 @@ func ResourceDNSDomainRecord() *schema.Resource {
 			"domain_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "ID from domain name",
+				ForceNew:    true,
+				Description: "ID from domain name, moving the record to another domain replaces it",
 			},
 			"type": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The choice of RR type from a, cname, mx or txt",
+				Description: "The choice of RR type from A, CNAME, MX, SRV or TXT",
 				ValidateFunc: validation.StringInSlice([]string{
 					civogo.DNSRecordTypeA,
 					civogo.DNSRecordTypeCName,
@@ -37,25 +39,44 @@ func ResourceDNSDomainRecord() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The portion before the domain name (e.g. www) or an @ for the apex/root domain (you cannot use an A record with an amex/root domain)",
+				Description: "The portion before the domain name (e.g. www), an @ for the apex/root domain or a * for a wildcard (e.g. * or *.sub), the names are relative to the domain",
 			},
 			"value": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "The IP address (A or MX), hostname (CNAME or MX) or text value (TXT) to serve for this record",
+				Description:  "The IP address (A or MX), hostname (CNAME, MX or the target of SRV) or text value (TXT) to serve for this record",
 				ValidateFunc: validation.NoZeroValues,
 			},
 			"priority": {
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.NoZeroValues,
-				Description:  "Useful for MX records only, the priority mail should be attempted it (defaults to 10)",
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Useful for MX and SRV records only, the priority mail should be attempted it (defaults to 10) or the priority of the target of the SRV record",
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Useful for SRV records only, the relative weight of the targets with the same priority",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "Useful for SRV records only, the port of the service on the target, the target host is set in `value`",
 			},
 			"ttl": {
 				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntBetween(600, 3600),
-				Description:  "How long caching DNS servers should cache this record for, in seconds (the minimum is 600 and the default if unspecified is 600)",
+				Optional:     true,
+				Default:      minRecordTTL,
+				ValidateFunc: validation.IntBetween(minRecordTTL, maxRecordTTL),
+				Description:  "How long caching DNS servers should cache this record for, in seconds (the minimum is 600, the maximum is 3600 and the default if unspecified is 600)",
+			},
+			"fail_if_exists": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When set to true, the record isn't created if the domain already has a record of the same type and name which isn't managed by this resource, e.g. a record created by hand in a shared domain. The record can be imported instead",
 			},
 			// Computed resource
 			"account_id": {
@@ -78,52 +99,28 @@ func ResourceDNSDomainRecord() *schema.Resource {
 		ReadContext:   resourceDNSDomainRecordRead,
 		UpdateContext: resourceDNSDomainRecordUpdate,
 		DeleteContext: resourceDNSDomainRecordDelete,
+		CustomizeDiff: dnsRecordCustomizeDiff,
 		//Exists: resourceExistsItem,
 		Importer: &schema.ResourceImporter{
-			State: resourceDNSDomainRecordImport,
+			StateContext: resourceDNSDomainRecordImport,
 		},
 	}
 }
 
 // function to create a new record for the main domain
 func resourceDNSDomainRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] configuring the domain record %s", d.Get("name").(string))
-	config := &civogo.DNSRecordConfig{
-		Name:  d.Get("name").(string),
-		Value: d.Get("value").(string),
-		TTL:   d.Get("ttl").(int),
-	}
+	tflog.Info(ctx, fmt.Sprintf("configuring the domain record %s", d.Get("name").(string)))
+	config := expandDNSRecordConfig(d)
 
-	if attr, ok := d.GetOk("priority"); ok {
-		if d.Get("type").(string) != "MX" {
-			return diag.Errorf("[WARN] warning priority value is only allow in the MX records")
+	if d.Get("fail_if_exists").(bool) {
+		if err := checkDNSRecordNotExists(apiClient, d.Get("domain_id").(string), config); err != nil {
+			return diag.FromErr(err)
 		}
-		config.Priority = attr.(int)
-	}
-
-	if d.Get("type").(string) == "A" {
-		config.Type = civogo.DNSRecordTypeA
-	}
-
-	if d.Get("type").(string) == "CNAME" {
-		config.Type = civogo.DNSRecordTypeCName
 	}
 
-	if d.Get("type").(string) == "MX" {
-		config.Type = civogo.DNSRecordTypeMX
-	}
-
-	if d.Get("type").(string) == "SRV" {
-		config.Type = civogo.DNSRecordTypeSRV
-	}
-
-	if d.Get("type").(string) == "TXT" {
-		config.Type = civogo.DNSRecordTypeTXT
-	}
-
-	log.Printf("[INFO] Creating the domain record %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Creating the domain record %s", d.Get("name").(string)))
 	dnsDomainRecord, err := apiClient.CreateDNSRecord(d.Get("domain_id").(string), config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create a new domain record: %s", err)
@@ -134,26 +131,58 @@ func resourceDNSDomainRecordCreate(ctx context.Context, d *schema.ResourceData,
 	return resourceDNSDomainRecordRead(ctx, d, m)
 }
 
+// checkDNSRecordNotExists fails when the domain already has a record of the same type and name, so a record
+// created outside of terraform isn't shadowed by the new record
+func checkDNSRecordNotExists(apiClient *civogo.Client, domainID string, config *civogo.DNSRecordConfig) error {
+	records, err := apiClient.ListDNSRecords(domainID)
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to list the records of the domain %s: %s", domainID, err)
+	}
+
+	domainName := ""
+	for _, record := range records {
+		if !strings.EqualFold(string(record.Type), string(config.Type)) {
+			continue
+		}
+		if domainName == "" && recordNameNeedsDomain(record.Name) {
+			if domainName, err = dnsDomainName(apiClient, domainID); err != nil {
+				return err
+			}
+		}
+		if normalizeRecordName(record.Name, domainName) == normalizeRecordName(config.Name, domainName) {
+			return fmt.Errorf("[ERR] the domain already has the %s record %s (%s) which isn't managed by this resource, import it with the ID %s:%s or remove `fail_if_exists`", config.Type, config.Name, record.Value, domainID, record.ID)
+		}
+	}
+
+	return nil
+}
+
 // function to read a dns domain record
-func resourceDNSDomainRecordRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceDNSDomainRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] retriving the domain record %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("retriving the domain record %s", d.Get("name").(string)))
 	resp, err := apiClient.GetDNSRecord(d.Get("domain_id").(string), d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "domain record")
 		}
 
 		return diag.Errorf("[WARN] error retrieving domain record: %s", err)
 	}
 
-	d.Set("name", resp.Name)
+	// the apex and the wildcards are normalized, the API can return them qualified by the domain
+	domainName := ""
+	if recordNameNeedsDomain(resp.Name) {
+		if domainName, err = dnsDomainName(apiClient, resp.DNSDomainID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.Set("account_id", resp.AccountID)
 	d.Set("domain_id", resp.DNSDomainID)
-	d.Set("name", resp.Name)
-	d.Set("value", resp.Value)
+	d.Set("name", normalizeRecordName(resp.Name, domainName))
+	flattenDNSRecordValue(d, resp)
 	d.Set("type", strings.ToUpper(string(resp.Type)))
 	d.Set("priority", resp.Priority)
 	d.Set("ttl", resp.TTL)
@@ -163,64 +192,38 @@ func resourceDNSDomainRecordRead(_ context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
-// function to update a dns domain record
+// function to update a dns domain record, the record is updated in place so it keeps resolving while
+// its value or its TTL changes
 func resourceDNSDomainRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
 	resp, err := apiClient.GetDNSRecord(d.Get("domain_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("[WARN] domain record (%s) not found", d.Id())
 	}
 
-	config := &civogo.DNSRecordConfig{}
-
-	if d.HasChange("name") || d.HasChange("value") || d.HasChange("priority") || d.HasChange("ttl") || d.HasChange("type") {
-		config.Name = d.Get("name").(string)
-		config.Value = d.Get("value").(string)
-		config.Priority = d.Get("priority").(int)
-		config.TTL = d.Get("ttl").(int)
+	config := expandDNSRecordConfig(d)
 
-		if d.Get("type").(string) == "A" {
-			config.Type = civogo.DNSRecordTypeA
-		}
-
-		if d.Get("type").(string) == "CNAME" {
-			config.Type = civogo.DNSRecordTypeCName
-		}
-
-		if d.Get("type").(string) == "MX" {
-			config.Type = civogo.DNSRecordTypeMX
-		}
-
-		if d.Get("type").(string) == "SRV" {
-			config.Type = civogo.DNSRecordTypeSRV
-		}
-
-		if d.Get("type").(string) == "TXT" {
-			config.Type = civogo.DNSRecordTypeTXT
-		}
-	}
-
-	log.Printf("[INFO] Updating the domain record %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Updating the domain record %s", d.Get("name").(string)))
 	_, err = apiClient.UpdateDNSRecord(resp, config)
 	if err != nil {
-		return diag.Errorf("[ERR] an error occurred while renamed the domain record %s, %s", d.Id(), err)
+		return diag.Errorf("[ERR] an error occurred while updating the domain record %s, %s", d.Id(), err)
 	}
 
 	return resourceDNSDomainRecordRead(ctx, d, m)
 }
 
 // function to delete a dns domain record
-func resourceDNSDomainRecordDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceDNSDomainRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] Searching the domain record %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("Searching the domain record %s", d.Get("name").(string)))
 	resp, err := apiClient.GetDNSRecord(d.Get("domain_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("[WARN] domain record (%s) not found", d.Id())
 	}
 
-	log.Printf("[INFO] deleting the domain record %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("deleting the domain record %s", d.Get("name").(string)))
 	_, err = apiClient.DeleteDNSRecord(resp)
 	if err != nil {
 		return diag.Errorf("[WARN] an error occurred while trying to delete the domain record %s", d.Id())
@@ -229,25 +232,36 @@ func resourceDNSDomainRecordDelete(_ context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-// custom import to able to add a main domain to the terraform
-func resourceDNSDomainRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
-
-	domainID, DomainRecordID, err := utils.ResourceCommonParseID(d.Id())
-	if err != nil {
-		return nil, err
-	}
-
-	log.Printf("[INFO] retriving the domain record %s", DomainRecordID)
-	resp, err := apiClient.GetDNSRecord(domainID, DomainRecordID)
-	if err != nil {
-		if resp != nil {
+// custom import to able to add a main domain to the terraform, the record is imported with the ID of its domain
+// and its ID (domain_id:record_id) or with the name of its domain, its type, its name and optionally its value
+// (example.com/A/www or example.com/A/www/10.0.0.1)
+func resourceDNSDomainRecordImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	apiClient := utils.ProviderClient(m)
+
+	var resp *civogo.DNSRecord
+	if strings.Contains(d.Id(), "/") {
+		tflog.Debug(ctx, fmt.Sprintf("searching the domain record %s", d.Id()))
+		record, err := findDNSRecordForImport(apiClient, d.Id())
+		if err != nil {
 			return nil, err
 		}
+		resp = record
+	} else {
+		domainID, DomainRecordID, err := utils.ResourceCommonParseID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("[ERR] unexpected format of ID (%s), expected domain_id:record_id or domain/type/name[/value]", d.Id())
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("retrieving the domain record %s", DomainRecordID))
+		record, err := apiClient.GetDNSRecord(domainID, DomainRecordID)
+		if err != nil {
+			return nil, fmt.Errorf("[ERR] failed to retrieve the domain record %s: %s", DomainRecordID, err)
+		}
+		resp = record
 	}
 
 	d.SetId(resp.ID)
-	d.Set("name", resp.Name)
+	d.Set("fail_if_exists", false)
 	d.Set("account_id", resp.AccountID)
 	d.Set("domain_id", resp.DNSDomainID)
 	d.Set("name", resp.Name)
@@ -260,3 +274,43 @@ func resourceDNSDomainRecordImport(d *schema.ResourceData, m interface{}) ([]*sc
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// findDNSRecordForImport returns the record matching the domain/type/name[/value] ID, the domain being its name
+// or its ID and the name being relative to the domain
+func findDNSRecordForImport(apiClient *civogo.Client, id string) (*civogo.DNSRecord, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("[ERR] unexpected format of ID (%s), expected domain/type/name[/value]", id)
+	}
+	recordType, name := strings.ToUpper(parts[1]), parts[2]
+
+	domain, err := findDNSDomainByNameOrID(apiClient, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := apiClient.ListDNSRecords(domain.ID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the records of the domain %s: %s", domain.Name, err)
+	}
+
+	matches := []civogo.DNSRecord{}
+	for _, record := range records {
+		if !strings.EqualFold(string(record.Type), recordType) || normalizeRecordName(record.Name, domain.Name) != normalizeRecordName(name, domain.Name) {
+			continue
+		}
+		if len(parts) == 4 && record.Value != parts[3] {
+			continue
+		}
+		matches = append(matches, record)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("[ERR] no %s record named %s found in the domain %s", recordType, name, domain.Name)
+	case 1:
+		return &matches[0], nil
+	}
+
+	return nil, fmt.Errorf("[ERR] %d %s records named %s found in the domain %s, add the value of the record to the ID (%s/%s/%s/<value>)", len(matches), recordType, name, domain.Name, parts[0], parts[1], name)
+}
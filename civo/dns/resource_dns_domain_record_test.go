@@ -2,10 +2,12 @@ package dns_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -77,6 +79,132 @@ func TestAccCivoDNSDomainNameRecord_update(t *testing.T) {
 	})
 }
 
+func TestAccCivoDNSDomainNameRecord_srv(t *testing.T) {
+	var domainRecord civogo.DNSRecord
+
+	// generate a random name for each test run
+	resName := "civo_dns_domain_record.sip"
+	var domainName = acctest.RandomWithPrefix("tf-test-record") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      CivoDNSDomainNameRecordConfigSRV(domainName, "sip"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the name of SRV records has to start with the service and the protocol"),
+			},
+			{
+				Config: CivoDNSDomainNameRecordConfigSRV(domainName, "_sip._tcp"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSDomainNameRecordResourceExists(resName, &domainRecord),
+					resource.TestCheckResourceAttr(resName, "type", "SRV"),
+					resource.TestCheckResourceAttr(resName, "priority", "10"),
+					resource.TestCheckResourceAttr(resName, "weight", "5"),
+					resource.TestCheckResourceAttr(resName, "port", "5060"),
+					resource.TestCheckResourceAttr(resName, "value", "sip.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoDNSDomainNameRecord_updateInPlace(t *testing.T) {
+	var domainRecord civogo.DNSRecord
+	var recordID string
+
+	// generate a random name for each test run
+	resName := "civo_dns_domain_record.www"
+	var domainName = acctest.RandomWithPrefix("tf-test-record") + ".example"
+	var recordName = acctest.RandomWithPrefix("record")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      CivoDNSDomainNameRecordConfigValue(domainName, recordName, "10.10.10.1", 60),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected ttl to be in the range \(600 - 3600\)`),
+			},
+			{
+				Config: CivoDNSDomainNameRecordConfigValue(domainName, recordName, "10.10.10.1", 600),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSDomainNameRecordResourceExists(resName, &domainRecord),
+					func(_ *terraform.State) error {
+						recordID = domainRecord.ID
+						return nil
+					},
+				),
+			},
+			{
+				// the record is updated in place, it keeps its ID
+				Config: CivoDNSDomainNameRecordConfigValue(domainName, recordName, "10.10.10.2", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSDomainNameRecordResourceExists(resName, &domainRecord),
+					func(_ *terraform.State) error {
+						if domainRecord.ID != recordID {
+							return fmt.Errorf("the record was replaced, expected the ID \"%s\", got: %#v", recordID, domainRecord.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resName, "value", "10.10.10.2"),
+					resource.TestCheckResourceAttr(resName, "ttl", "3600"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCivoDNSDomainNameRecord_apexAndWildcard tests the apex and wildcard names don't cause a diff once created
+func TestAccCivoDNSDomainNameRecord_apexAndWildcard(t *testing.T) {
+	var domainName = acctest.RandomWithPrefix("tf-test-record") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      CivoDNSDomainNameRecordConfigApexAndWildcard(domainName, "www.*"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the wildcard has to be the first label of the name"),
+			},
+			{
+				Config: CivoDNSDomainNameRecordConfigApexAndWildcard(domainName, "*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("civo_dns_domain_record.apex", "name", "@"),
+					resource.TestCheckResourceAttr("civo_dns_domain_record.wildcard", "name", "*"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCivoDNSDomainNameRecord_failIfExists tests a record isn't created over an existing record of the same name
+func TestAccCivoDNSDomainNameRecord_failIfExists(t *testing.T) {
+	var domainName = acctest.RandomWithPrefix("tf-test-record") + ".example"
+	var recordName = acctest.RandomWithPrefix("record")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoDNSDomainNameRecordConfigBasic(domainName, recordName),
+			},
+			{
+				Config:      CivoDNSDomainNameRecordConfigFailIfExists(domainName, recordName),
+				ExpectError: regexp.MustCompile("which isn't managed by this resource"),
+			},
+		},
+	})
+}
+
 func CivoDNSDomainNameRecordValues(domainRecord *civogo.DNSRecord, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if domainRecord.Name != name {
@@ -95,7 +223,7 @@ func CivoDNSDomainNameRecordResourceExists(n string, domainRecord *civogo.DNSRec
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.GetDNSRecord(rs.Primary.Attributes["domain_id"], rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Domain record not found: (%s) %s", rs.Primary.ID, err)
@@ -119,7 +247,7 @@ func CivoDNSDomainNameRecordUpdated(domainRecord *civogo.DNSRecord, name string)
 }
 
 func CivoDNSDomainNameRecordDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_dns_domain_record" {
@@ -166,3 +294,75 @@ resource "civo_dns_domain_record" "www" {
 }
 `, domain, record)
 }
+
+func CivoDNSDomainNameRecordConfigSRV(domain string, record string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "foobar" {
+	name = "%s"
+}
+
+resource "civo_dns_domain_record" "sip" {
+    domain_id = civo_dns_domain_name.foobar.id
+    type = "SRV"
+    name = "%s"
+    value = "sip.example.com"
+    priority = 10
+    weight = 5
+    port = 5060
+    ttl = 600
+}
+`, domain, record)
+}
+
+func CivoDNSDomainNameRecordConfigValue(domain string, record string, value string, ttl int) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "foobar" {
+	name = "%s"
+}
+
+resource "civo_dns_domain_record" "www" {
+    domain_id = civo_dns_domain_name.foobar.id
+    type = "A"
+    name = "%s"
+    value = "%s"
+    ttl = %d
+}
+`, domain, record, value, ttl)
+}
+
+func CivoDNSDomainNameRecordConfigApexAndWildcard(domain string, wildcard string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "foobar" {
+	name = "%s"
+}
+
+resource "civo_dns_domain_record" "apex" {
+    domain_id = civo_dns_domain_name.foobar.id
+    type = "A"
+    name = "@"
+    value = "10.10.10.1"
+    ttl = 600
+}
+
+resource "civo_dns_domain_record" "wildcard" {
+    domain_id = civo_dns_domain_name.foobar.id
+    type = "CNAME"
+    name = "%s"
+    value = "www.example.com"
+    ttl = 600
+}
+`, domain, wildcard)
+}
+
+func CivoDNSDomainNameRecordConfigFailIfExists(domain string, record string) string {
+	return CivoDNSDomainNameRecordConfigBasic(domain, record) + fmt.Sprintf(`
+resource "civo_dns_domain_record" "duplicate" {
+    domain_id = civo_dns_domain_name.foobar.id
+    type = "A"
+    name = "%s"
+    value = "10.10.10.2"
+    ttl = 600
+    fail_if_exists = true
+}
+`, record)
+}
@@ -0,0 +1,319 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDNSZoneRecords DNS zone records resource with this we can manage all the records of a domain at once
+func ResourceDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: strings.Join([]string{
+			"Provides a Civo DNS zone records resource, managing all the records of a domain at once from `record` blocks or from a zone file in the BIND format.",
+			"The records of the domain are reconciled with the declared records: the missing records are created and the records that aren't declared are deleted, so the domain shouldn't have records managed by `civo_dns_domain_record` too.",
+			"The SOA and NS records of a zone file are skipped as they're served by Civo, and the TTLs are brought between 600 and 3600 seconds with a warning.",
+		}, "\n\n"),
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "ID from domain name",
+			},
+			"zone_file": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"zone_file", "record"},
+				ValidateDiagFunc: validateZoneFile,
+				Description:      "The records of the domain as a zone file in the BIND format, the absolute names have to be part of the domain set with `$ORIGIN`",
+			},
+			"record": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"zone_file", "record"},
+				Elem:         zoneRecordSchema(),
+				Description:  "The records of the domain, they're read from `zone_file` when it's set",
+			},
+		},
+		CreateContext: resourceDNSZoneRecordsCreate,
+		ReadContext:   resourceDNSZoneRecordsRead,
+		UpdateContext: resourceDNSZoneRecordsUpdate,
+		DeleteContext: resourceDNSZoneRecordsDelete,
+		CustomizeDiff: zoneRecordsCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSZoneRecordsImport,
+		},
+	}
+}
+
+func zoneRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The choice of RR type from A, CNAME, MX, SRV or TXT",
+				ValidateFunc: validation.StringInSlice([]string{
+					civogo.DNSRecordTypeA,
+					civogo.DNSRecordTypeCName,
+					civogo.DNSRecordTypeMX,
+					civogo.DNSRecordTypeTXT,
+					civogo.DNSRecordTypeSRV,
+				}, false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The portion before the domain name (e.g. www), an @ for the apex/root domain or a * for a wildcard (e.g. * or *.sub)",
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The IP address (A or MX), hostname (CNAME or MX), weight, port and target (SRV, e.g. `5 5060 sip.example.com`) or text value (TXT) to serve for this record",
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Description:  "Useful for MX and SRV records only, the priority of the record",
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      minRecordTTL,
+				ValidateFunc: validation.IntBetween(minRecordTTL, maxRecordTTL),
+				Description:  "How long caching DNS servers should cache this record for, in seconds (the minimum is 600 and the default if unspecified is 600)",
+			},
+		},
+	}
+}
+
+// zoneRecordsCustomizeDiff plans the records parsed from the zone file, and checks the priority is only set for
+// the records that have one
+func zoneRecordsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if zoneFile, ok := d.GetOk("zone_file"); ok || !d.NewValueKnown("zone_file") {
+		if !d.NewValueKnown("zone_file") {
+			return d.SetNewComputed("record")
+		}
+
+		// the TTLs changed to be in range are warned about when the zone file is validated
+		records, _, err := parseZoneFile(zoneFile.(string))
+		if err != nil {
+			return err
+		}
+		return d.SetNew("record", flattenZoneRecords(records))
+	}
+
+	for _, record := range expandZoneRecords(d.Get("record").(*schema.Set).List()) {
+		if record.Priority != 0 && !zoneRecordHasPriority(record.Type) {
+			return fmt.Errorf("[ERR] `priority` can only be set for MX and SRV records, not %s records", record.Type)
+		}
+	}
+
+	return nil
+}
+
+// zoneRecordHasPriority reports whether the records of the type have a priority
+func zoneRecordHasPriority(recordType string) bool {
+	return recordType == civogo.DNSRecordTypeMX || recordType == civogo.DNSRecordTypeSRV
+}
+
+// expandZoneRecords expands the `record` blocks
+func expandZoneRecords(objects []interface{}) []zoneRecord {
+	records := make([]zoneRecord, 0, len(objects))
+	for _, object := range objects {
+		record := object.(map[string]interface{})
+		records = append(records, zoneRecord{
+			Type:     record["type"].(string),
+			Name:     record["name"].(string),
+			Value:    record["value"].(string),
+			Priority: record["priority"].(int),
+			TTL:      record["ttl"].(int),
+		})
+	}
+
+	return records
+}
+
+// flattenZoneRecords flattens the records into `record` blocks
+func flattenZoneRecords(records []zoneRecord) []interface{} {
+	flattenedRecords := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		flattenedRecords = append(flattenedRecords, map[string]interface{}{
+			"type":     record.Type,
+			"name":     record.Name,
+			"value":    record.Value,
+			"priority": record.Priority,
+			"ttl":      record.TTL,
+		})
+	}
+
+	return flattenedRecords
+}
+
+// zoneRecordFromDNSRecord returns the record of the domain as a zone record, the name is relative to the domain
+// and the priority of the records without one is ignored
+func zoneRecordFromDNSRecord(record civogo.DNSRecord, domainName string) zoneRecord {
+	r := zoneRecord{
+		Type:  strings.ToUpper(string(record.Type)),
+		Name:  normalizeRecordName(record.Name, domainName),
+		Value: record.Value,
+		TTL:   record.TTL,
+	}
+	if zoneRecordHasPriority(r.Type) {
+		r.Priority = record.Priority
+	}
+
+	return r
+}
+
+// reconcileZoneRecords makes the records of the domain match the declared records: the missing records are
+// created first and then the records that aren't declared are deleted
+func reconcileZoneRecords(ctx context.Context, apiClient *civogo.Client, domainID string, records []zoneRecord) error {
+	current, err := apiClient.ListDNSRecords(domainID)
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to list the records of the domain %s: %s", domainID, err)
+	}
+	domainName, err := dnsDomainName(apiClient, domainID)
+	if err != nil {
+		return err
+	}
+
+	// the records of the domain by key, a key can be used by several records
+	existing := map[string][]civogo.DNSRecord{}
+	for _, record := range current {
+		key := zoneRecordFromDNSRecord(record, domainName).key()
+		existing[key] = append(existing[key], record)
+	}
+
+	for _, record := range records {
+		if key := record.key(); len(existing[key]) > 0 {
+			// the record is kept
+			existing[key] = existing[key][1:]
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("creating the %s record %s of the domain %s", record.Type, record.Name, domainID))
+		_, err := apiClient.CreateDNSRecord(domainID, &civogo.DNSRecordConfig{
+			Type:     civogo.DNSRecordType(record.Type),
+			Name:     record.Name,
+			Value:    record.Value,
+			Priority: record.Priority,
+			TTL:      record.TTL,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] failed to create the %s record %s of the domain %s: %s", record.Type, record.Name, domainID, err)
+		}
+	}
+
+	for _, remaining := range existing {
+		for _, record := range remaining {
+			tflog.Info(ctx, fmt.Sprintf("deleting the %s record %s of the domain %s", record.Type, record.Name, domainID))
+			if _, err := apiClient.DeleteDNSRecord(&record); err != nil {
+				return fmt.Errorf("[ERR] failed to delete the %s record %s of the domain %s: %s", record.Type, record.Name, domainID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// function to create the records of the domain
+func resourceDNSZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
+
+	domainID := d.Get("domain_id").(string)
+	if err := reconcileZoneRecords(ctx, apiClient, domainID, expandZoneRecords(d.Get("record").(*schema.Set).List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(domainID)
+
+	return resourceDNSZoneRecordsRead(ctx, d, m)
+}
+
+// function to read the records of the domain
+func resourceDNSZoneRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
+
+	tflog.Info(ctx, fmt.Sprintf("retriving the records of the domain %s", d.Id()))
+	current, err := apiClient.ListDNSRecords(d.Id())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "domain records")
+		}
+
+		return diag.Errorf("[ERR] failed to list the records of the domain %s: %s", d.Id(), err)
+	}
+
+	domainName, err := dnsDomainName(apiClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records := make([]zoneRecord, 0, len(current))
+	for _, record := range current {
+		records = append(records, zoneRecordFromDNSRecord(record, domainName))
+	}
+
+	d.Set("domain_id", d.Id())
+	if err := d.Set("record", flattenZoneRecords(records)); err != nil {
+		return diag.Errorf("[ERR] error setting the records of the domain: %s", err)
+	}
+
+	return nil
+}
+
+// function to update the records of the domain
+func resourceDNSZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
+
+	if d.HasChange("record") {
+		if err := reconcileZoneRecords(ctx, apiClient, d.Id(), expandZoneRecords(d.Get("record").(*schema.Set).List())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDNSZoneRecordsRead(ctx, d, m)
+}
+
+// function to delete the records of the domain
+func resourceDNSZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
+
+	if err := reconcileZoneRecords(ctx, apiClient, d.Id(), nil); err != nil {
+		if utils.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// the records of a domain are imported with the name or the ID of the domain
+func resourceDNSZoneRecordsImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	apiClient := utils.ProviderClient(m)
+
+	domain, err := findDNSDomainByNameOrID(apiClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(domain.ID)
+	d.Set("domain_id", domain.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,155 @@
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccCivoDNSZoneRecords_zoneFile tests the records of a domain are reconciled with a zone file
+func TestAccCivoDNSZoneRecords_zoneFile(t *testing.T) {
+	// generate a random name for each test run
+	resName := "civo_dns_zone_records.foobar"
+	var domainName = acctest.RandomWithPrefix("tf-test-zone") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      CivoDNSZoneRecordsConfigZoneFile(domainName, "www IN AAAA ::1"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the type AAAA isn't supported"),
+			},
+			{
+				Config: CivoDNSZoneRecordsConfigZoneFile(domainName, "www IN A 10.10.10.1\nmail 3600 IN MX 10 mx.example.com."),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSZoneRecordsCount(resName, 2),
+					resource.TestCheckResourceAttr(resName, "record.#", "2"),
+				),
+			},
+			{
+				Config: CivoDNSZoneRecordsConfigZoneFile(domainName, "www IN A 10.10.10.2\n@ IN TXT \"v=spf1 -all\"\n_sip._tcp IN SRV 10 5 5060 sip.example.com."),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSZoneRecordsCount(resName, 3),
+					resource.TestCheckResourceAttr(resName, "record.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+// TestAccCivoDNSZoneRecords_records tests the records of a domain are reconciled with `record` blocks
+func TestAccCivoDNSZoneRecords_records(t *testing.T) {
+	// generate a random name for each test run
+	resName := "civo_dns_zone_records.foobar"
+	var domainName = acctest.RandomWithPrefix("tf-test-zone") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoDNSZoneRecordsConfigRecords(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSZoneRecordsCount(resName, 2),
+					resource.TestCheckResourceAttr(resName, "record.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func CivoDNSZoneRecordsCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
+		records, err := client.ListDNSRecords(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Domain records not found: (%s) %s", rs.Primary.ID, err)
+		}
+		if len(records) != count {
+			return fmt.Errorf("bad number of records, expected %d, got: %d", count, len(records))
+		}
+
+		return nil
+	}
+}
+
+func CivoDNSZoneRecordsDestroy(s *terraform.State) error {
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "civo_dns_zone_records" {
+			continue
+		}
+
+		records, err := client.ListDNSRecords(rs.Primary.ID)
+		if err == nil && len(records) > 0 {
+			return fmt.Errorf("Domain records still exist")
+		}
+	}
+
+	return nil
+}
+
+func CivoDNSZoneRecordsConfigZoneFile(domain string, records string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "foobar" {
+	name = "%s"
+}
+
+resource "civo_dns_zone_records" "foobar" {
+    domain_id = civo_dns_domain_name.foobar.id
+    zone_file = <<-EOT
+$ORIGIN %s.
+$TTL 600
+%s
+EOT
+}
+`, domain, domain, records)
+}
+
+func CivoDNSZoneRecordsConfigRecords(domain string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "foobar" {
+	name = "%s"
+}
+
+resource "civo_dns_zone_records" "foobar" {
+    domain_id = civo_dns_domain_name.foobar.id
+
+    record {
+        type = "A"
+        name = "www"
+        value = "10.10.10.1"
+    }
+
+    record {
+        type = "MX"
+        name = "@"
+        value = "mx.example.com"
+        priority = 10
+        ttl = 3600
+    }
+}
+`, domain)
+}
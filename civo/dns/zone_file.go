@@ -0,0 +1,291 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// minRecordTTL and maxRecordTTL are the TTLs the records can have
+	minRecordTTL = 600
+	maxRecordTTL = 3600
+)
+
+// zoneRecord is a record of a zone, as declared in a `record` block or parsed from a zone file
+type zoneRecord struct {
+	Type     string
+	Name     string
+	Value    string
+	Priority int
+	TTL      int
+}
+
+// key identifies the record by its content, the records of the domain are reconciled with the configured
+// records by their key
+func (r zoneRecord) key() string {
+	return strings.Join([]string{strings.ToUpper(r.Type), r.Name, r.Value, strconv.Itoa(r.Priority), strconv.Itoa(r.TTL)}, "|")
+}
+
+// skippedZoneRecordTypes are the types of the records of a zone file that aren't managed, the SOA and NS records
+// of the domains are served by Civo
+var skippedZoneRecordTypes = []string{"SOA", "NS"}
+
+// parseZoneFile parses the records of a zone file in the BIND format. The `$ORIGIN` and `$TTL` directives are
+// supported, the names are relative to the origin and the TTLs are brought into the range the records can have,
+// a warning is returned for every TTL that is changed.
+func parseZoneFile(zoneFile string) ([]zoneRecord, []string, error) {
+	origin, defaultTTL, previousName := "", minRecordTTL, "@"
+	records := []zoneRecord{}
+	warnings := []string{}
+
+	lines, err := zoneFileLines(zoneFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, line := range lines {
+		fields, err := zoneFileFields(line.text)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: %s", line.number, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) != 2 {
+				return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: $ORIGIN needs a domain name", line.number)
+			}
+			origin = strings.TrimSuffix(strings.ToLower(fields[1]), ".")
+			continue
+		case "$TTL":
+			if len(fields) != 2 {
+				return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: $TTL needs a number of seconds", line.number)
+			}
+			if defaultTTL, err = strconv.Atoi(fields[1]); err != nil {
+				return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: invalid $TTL %s", line.number, fields[1])
+			}
+			continue
+		}
+
+		// the lines starting with a blank are records of the same name as the previous record
+		name := previousName
+		if !line.continued {
+			name, fields = fields[0], fields[1:]
+		}
+		previousName = name
+
+		record := zoneRecord{TTL: defaultTTL}
+		if record.Name, err = relativeZoneName(name, origin); err != nil {
+			return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: %s", line.number, err)
+		}
+
+		// the TTL and the class can be in any order before the type
+		for len(fields) > 0 {
+			if ttl, err := strconv.Atoi(fields[0]); err == nil {
+				record.TTL = ttl
+			} else if !strings.EqualFold(fields[0], "IN") {
+				break
+			}
+			fields = fields[1:]
+		}
+		if len(fields) < 2 {
+			return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: expected a record type and its data", line.number)
+		}
+
+		record.Type, fields = strings.ToUpper(fields[0]), fields[1:]
+		if slices.Contains(skippedZoneRecordTypes, record.Type) {
+			continue
+		}
+
+		if err := parseZoneRecordData(&record, fields, origin); err != nil {
+			return nil, nil, fmt.Errorf("[ERR] line %d of the zone file: %s", line.number, err)
+		}
+		if ttl := min(max(record.TTL, minRecordTTL), maxRecordTTL); ttl != record.TTL {
+			warnings = append(warnings, fmt.Sprintf("line %d of the zone file: the TTL of the %s record %s is %d seconds, it's changed to %d as the TTLs have to be between %d and %d seconds",
+				line.number, record.Type, record.Name, record.TTL, ttl, minRecordTTL, maxRecordTTL))
+			record.TTL = ttl
+		}
+
+		records = append(records, record)
+	}
+
+	return records, warnings, nil
+}
+
+// validateZoneFile checks the zone file can be parsed, and warns about the TTLs changed to be in range
+func validateZoneFile(v interface{}, path cty.Path) diag.Diagnostics {
+	_, warnings, err := parseZoneFile(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{Severity: diag.Error, Summary: err.Error(), AttributePath: path}}
+	}
+
+	var diags diag.Diagnostics
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: warning, AttributePath: path})
+	}
+
+	return diags
+}
+
+// parseZoneRecordData sets the value and the priority of the record from its data in the zone file
+func parseZoneRecordData(record *zoneRecord, data []string, origin string) error {
+	switch record.Type {
+	case civogo.DNSRecordTypeA, civogo.DNSRecordTypeCName:
+		if len(data) != 1 {
+			return fmt.Errorf("%s records have a single value, got: %s", record.Type, strings.Join(data, " "))
+		}
+		record.Value = zoneTarget(data[0], origin)
+	case civogo.DNSRecordTypeMX:
+		if len(data) != 2 {
+			return fmt.Errorf("MX records have a priority and a mail server, got: %s", strings.Join(data, " "))
+		}
+		priority, err := strconv.Atoi(data[0])
+		if err != nil {
+			return fmt.Errorf("invalid priority of the MX record %s", data[0])
+		}
+		record.Priority, record.Value = priority, zoneTarget(data[1], origin)
+	case civogo.DNSRecordTypeSRV:
+		if len(data) != 4 {
+			return fmt.Errorf("SRV records have a priority, a weight, a port and a target, got: %s", strings.Join(data, " "))
+		}
+		priority, err := strconv.Atoi(data[0])
+		if err != nil {
+			return fmt.Errorf("invalid priority of the SRV record %s", data[0])
+		}
+		record.Priority, record.Value = priority, fmt.Sprintf("%s %s %s", data[1], data[2], zoneTarget(data[3], origin))
+	case civogo.DNSRecordTypeTXT:
+		record.Value = strings.Join(data, "")
+	default:
+		return fmt.Errorf("the type %s isn't supported, the supported types are A, CNAME, MX, SRV and TXT", record.Type)
+	}
+
+	return nil
+}
+
+// relativeZoneName returns the name relative to the origin, `@` being the origin itself
+func relativeZoneName(name, origin string) (string, error) {
+	if name == "@" || !strings.HasSuffix(name, ".") {
+		return name, nil
+	}
+
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	switch {
+	case origin == "":
+		return "", fmt.Errorf("the name %s. is absolute, set the domain with $ORIGIN or use a relative name", name)
+	case name == origin:
+		return "@", nil
+	case strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin), nil
+	}
+
+	return "", fmt.Errorf("the name %s. isn't part of the domain %s", name, origin)
+}
+
+// zoneTarget returns the hostname of the data of a record, `@` is the origin and the trailing dot of absolute
+// names is removed as the records are stored without it
+func zoneTarget(target, origin string) string {
+	if target == "@" && origin != "" {
+		return origin
+	}
+
+	return strings.TrimSuffix(target, ".")
+}
+
+// zoneFileLine is a logical line of a zone file, the lines between parentheses are joined
+type zoneFileLine struct {
+	number    int
+	text      string
+	continued bool
+}
+
+// zoneFileLines splits the zone file into its logical lines without their comments
+func zoneFileLines(zoneFile string) ([]zoneFileLine, error) {
+	lines := []zoneFileLine{}
+	scanner := bufio.NewScanner(strings.NewReader(zoneFile))
+
+	var current *zoneFileLine
+	for number := 1; scanner.Scan(); number++ {
+		text := stripZoneComment(scanner.Text())
+
+		if current != nil {
+			current.text += " " + text
+		} else {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			current = &zoneFileLine{number: number, text: text, continued: text[0] == ' ' || text[0] == '\t'}
+		}
+
+		// the line goes on until its parentheses are closed
+		if strings.Count(current.text, "(") > strings.Count(current.text, ")") {
+			continue
+		}
+		current.text = strings.NewReplacer("(", " ", ")", " ").Replace(current.text)
+		lines = append(lines, *current)
+		current = nil
+	}
+	if current != nil {
+		return nil, fmt.Errorf("[ERR] line %d of the zone file: the parenthesis isn't closed", current.number)
+	}
+
+	return lines, scanner.Err()
+}
+
+// stripZoneComment removes the comment of the line, the semicolons in quoted strings aren't comments
+func stripZoneComment(line string) string {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"' && (i == 0 || line[i-1] != '\\'):
+			quoted = !quoted
+		case c == ';' && !quoted:
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+// zoneFileFields splits the line into its fields, the quoted strings are a single field without the quotes
+func zoneFileFields(line string) ([]string, error) {
+	fields := []string{}
+	var field strings.Builder
+	quoted, inField := false, false
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && quoted && i+1 < len(line):
+			i++
+			field.WriteByte(line[i])
+		case c == '"':
+			quoted, inField = !quoted, true
+		case (c == ' ' || c == '\t') && !quoted:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteByte(c)
+			inField = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("the quoted string isn't closed")
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+
+	return fields, nil
+}
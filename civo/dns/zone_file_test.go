@@ -0,0 +1,178 @@
+package dns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		zoneFile string
+		records  []zoneRecord
+		warnings []string
+		err      string
+	}{
+		{
+			name:     "origin and default TTL",
+			zoneFile: "$ORIGIN example.com.\n$TTL 3600\nwww IN A 10.0.0.1",
+			records:  []zoneRecord{{Type: "A", Name: "www", Value: "10.0.0.1", TTL: 3600}},
+		},
+		{
+			name:     "the default TTL is the minimum without $TTL",
+			zoneFile: "www IN A 10.0.0.1",
+			records:  []zoneRecord{{Type: "A", Name: "www", Value: "10.0.0.1", TTL: 600}},
+		},
+		{
+			name:     "absolute names are relative to the origin",
+			zoneFile: "$ORIGIN example.com.\nexample.com. IN A 10.0.0.1\nMail.Example.com. IN A 10.0.0.2",
+			records: []zoneRecord{
+				{Type: "A", Name: "@", Value: "10.0.0.1", TTL: 600},
+				{Type: "A", Name: "mail", Value: "10.0.0.2", TTL: 600},
+			},
+		},
+		{
+			name:     "the TTL and the class in any order",
+			zoneFile: "www 1200 IN A 10.0.0.1\napi IN 1800 A 10.0.0.2\ncdn 900 CNAME www",
+			records: []zoneRecord{
+				{Type: "A", Name: "www", Value: "10.0.0.1", TTL: 1200},
+				{Type: "A", Name: "api", Value: "10.0.0.2", TTL: 1800},
+				{Type: "CNAME", Name: "cdn", Value: "www", TTL: 900},
+			},
+		},
+		{
+			name:     "lines starting with a blank have the previous name",
+			zoneFile: "www IN A 10.0.0.1\n\tIN TXT \"hello\"",
+			records: []zoneRecord{
+				{Type: "A", Name: "www", Value: "10.0.0.1", TTL: 600},
+				{Type: "TXT", Name: "www", Value: "hello", TTL: 600},
+			},
+		},
+		{
+			name:     "comments are removed",
+			zoneFile: "; the web server\nwww IN A 10.0.0.1 ; trailing comment",
+			records:  []zoneRecord{{Type: "A", Name: "www", Value: "10.0.0.1", TTL: 600}},
+		},
+		{
+			name:     "quoted strings keep their semicolons and escaped quotes",
+			zoneFile: "@ IN TXT \"v=spf1 include:example.net; -all\"\nnote IN TXT \"say \\\"hi\\\"\"",
+			records: []zoneRecord{
+				{Type: "TXT", Name: "@", Value: "v=spf1 include:example.net; -all", TTL: 600},
+				{Type: "TXT", Name: "note", Value: "say \"hi\"", TTL: 600},
+			},
+		},
+		{
+			name:     "the strings of TXT records are joined",
+			zoneFile: "@ IN TXT \"abc\" \"def\"",
+			records:  []zoneRecord{{Type: "TXT", Name: "@", Value: "abcdef", TTL: 600}},
+		},
+		{
+			name:     "multi-line records and skipped SOA and NS records",
+			zoneFile: "$ORIGIN example.com.\n@ IN SOA ns1.example.com. admin.example.com. (\n  1 ; serial\n  3600\n  600\n  86400\n  600 )\n@ IN NS ns1.example.com.\ndkim IN TXT ( \"part1\"\n  \"part2\" )",
+			records:  []zoneRecord{{Type: "TXT", Name: "dkim", Value: "part1part2", TTL: 600}},
+		},
+		{
+			name:     "MX records",
+			zoneFile: "$ORIGIN example.com.\n@ IN MX 10 mail.example.com.\n@ IN MX 20 @",
+			records: []zoneRecord{
+				{Type: "MX", Name: "@", Value: "mail.example.com", Priority: 10, TTL: 600},
+				{Type: "MX", Name: "@", Value: "example.com", Priority: 20, TTL: 600},
+			},
+		},
+		{
+			name:     "SRV records",
+			zoneFile: "_sip._tcp IN SRV 0 5 5060 sip.example.com.",
+			records:  []zoneRecord{{Type: "SRV", Name: "_sip._tcp", Value: "5 5060 sip.example.com", Priority: 0, TTL: 600}},
+		},
+		{
+			name:     "TTLs out of range are changed with a warning",
+			zoneFile: "$TTL 86400\nwww IN A 10.0.0.1\nlow 60 IN A 10.0.0.2",
+			records: []zoneRecord{
+				{Type: "A", Name: "www", Value: "10.0.0.1", TTL: 3600},
+				{Type: "A", Name: "low", Value: "10.0.0.2", TTL: 600},
+			},
+			warnings: []string{"line 2 of the zone file: the TTL of the A record www is 86400 seconds", "line 3 of the zone file: the TTL of the A record low is 60 seconds"},
+		},
+		{
+			name:     "unsupported types",
+			zoneFile: "www IN AAAA ::1",
+			err:      "line 1 of the zone file: the type AAAA isn't supported",
+		},
+		{
+			name:     "absolute names without origin",
+			zoneFile: "www.example.com. IN A 10.0.0.1",
+			err:      "set the domain with $ORIGIN",
+		},
+		{
+			name:     "absolute names outside the origin",
+			zoneFile: "$ORIGIN example.com.\nwww.example.net. IN A 10.0.0.1",
+			err:      "isn't part of the domain example.com",
+		},
+		{
+			name:     "invalid $TTL",
+			zoneFile: "$TTL 1h\nwww IN A 10.0.0.1",
+			err:      "line 1 of the zone file: invalid $TTL 1h",
+		},
+		{
+			name:     "unclosed parenthesis",
+			zoneFile: "www IN TXT ( \"abc\"\n",
+			err:      "line 1 of the zone file: the parenthesis isn't closed",
+		},
+		{
+			name:     "unclosed quote",
+			zoneFile: "www IN TXT \"abc",
+			err:      "line 1 of the zone file: the quoted string isn't closed",
+		},
+		{
+			name:     "MX records without a mail server",
+			zoneFile: "@ IN MX 10",
+			err:      "MX records have a priority and a mail server",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			records, warnings, err := parseZoneFile(c.zoneFile)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("expected the error %q, got: %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(records, c.records) {
+				t.Errorf("bad records, expected %+v, got: %+v", c.records, records)
+			}
+			if len(warnings) != len(c.warnings) {
+				t.Fatalf("bad warnings, expected %q, got: %q", c.warnings, warnings)
+			}
+			for i, warning := range c.warnings {
+				if !strings.HasPrefix(warnings[i], warning) {
+					t.Errorf("bad warning, expected %q, got: %q", warning, warnings[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateZoneFile(t *testing.T) {
+	diags := validateZoneFile("$TTL 86400\nwww IN A 10.0.0.1", nil)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning for the changed TTL, got: %+v", diags)
+	}
+
+	diags = validateZoneFile("www IN AAAA ::1", nil)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for the unsupported type, got: %+v", diags)
+	}
+
+	if diags := validateZoneFile("www IN A 10.0.0.1", nil); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got: %+v", diags)
+	}
+}
@@ -2,10 +2,12 @@ package firewall
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +21,7 @@ func DataSourceFirewall() *schema.Resource {
 			"Retrieve information about a firewall for use in other resources.",
 			"This data source provides all of the firewall's properties as configured on your Civo account.",
 			"Firewalls may be looked up by id or name, and you can optionally pass region if you want to make a lookup for a specific firewall inside that region.",
+			"The ingress and egress rules of the firewall are exported too, so a centrally managed firewall can be audited without importing it.",
 		}, "\n\n"),
 		ReadContext: dataSourceFirewallRead,
 		Schema: map[string]*schema.Schema{
@@ -47,22 +50,108 @@ func DataSourceFirewall() *schema.Resource {
 				Computed:    true,
 				Description: "The id of the associated network",
 			},
+			"ingress_rule": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dataSourceFirewallRuleSchema(),
+				Description: "The ingress rules of the firewall",
+			},
+			"egress_rule": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dataSourceFirewallRuleSchema(),
+				Description: "The egress rules of the firewall",
+			},
+			"instance_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of instances using the firewall",
+			},
+			"cluster_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of Kubernetes clusters using the firewall",
+			},
+			"loadbalancer_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of load balancers using the firewall",
+			},
+		},
+	}
+}
+
+// dataSourceFirewallRuleSchema is the schema of a rule of the firewall read by the data source
+func dataSourceFirewallRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the firewall rule",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of the firewall rule",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol of the firewall rule, `tcp`, `udp` or `icmp`",
+			},
+			"port_range": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The port or port range of the firewall rule",
+			},
+			"cidr": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CIDRs of the other end of the firewall rule",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action of the firewall rule, `allow` or `deny`",
+			},
 		},
 	}
 }
 
-func dataSourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+// findFirewallByName returns the firewall with exactly the given name, and errors when no firewall or more
+// than one firewall have the name
+func findFirewallByName(apiClient *civogo.Client, name string) (*civogo.Firewall, error) {
+	firewalls, err := apiClient.ListFirewalls()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the firewalls: %s", err)
+	}
+
+	found := []civogo.Firewall{}
+	for _, firewall := range firewalls {
+		if firewall.Name == name {
+			found = append(found, firewall)
+		}
+	}
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	if len(found) == 0 {
+		return nil, fmt.Errorf("[ERR] no firewall named %s was found in the region %s", name, apiClient.Region)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("[ERR] more than one firewall named %s was found in the region %s, use the id instead", name, apiClient.Region)
 	}
 
+	return &found[0], nil
+}
+
+func dataSourceFirewallRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
 	var foundFirewall *civogo.Firewall
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the firewall by id")
+		tflog.Info(ctx, "Getting the firewall by id")
 		firewall, err := apiClient.FindFirewall(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive firewall: %s", err)
@@ -70,19 +159,34 @@ func dataSourceFirewallRead(_ context.Context, d *schema.ResourceData, m interfa
 
 		foundFirewall = firewall
 	} else if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the firewall by name")
-		firewall, err := apiClient.FindFirewall(name.(string))
+		tflog.Info(ctx, "Getting the firewall by name")
+		firewall, err := findFirewallByName(apiClient, name.(string))
 		if err != nil {
-			return diag.Errorf("[ERR] failed to retrive firewall: %s", err)
+			return diag.FromErr(err)
 		}
 
 		foundFirewall = firewall
 	}
 
+	rules, err := apiClient.ListFirewallRules(foundFirewall.ID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to list the rules of the firewall: %s", err)
+	}
+
 	d.SetId(foundFirewall.ID)
 	d.Set("name", foundFirewall.Name)
 	d.Set("network_id", foundFirewall.NetworkID)
 	d.Set("region", apiClient.Region)
+	d.Set("instance_count", foundFirewall.InstanceCount)
+	d.Set("cluster_count", foundFirewall.ClusterCount)
+	d.Set("loadbalancer_count", foundFirewall.LoadBalancerCount)
+
+	if err := d.Set("ingress_rule", flattenFirewallRules(rules, "ingress")); err != nil {
+		return diag.Errorf("[ERR] error setting ingress rules: %s", err)
+	}
+	if err := d.Set("egress_rule", flattenFirewallRules(rules, "egress")); err != nil {
+		return diag.Errorf("[ERR] error setting egress rules: %s", err)
+	}
 
 	return nil
 }
@@ -21,6 +21,8 @@ func TestAccDataSourceCivoFirewall_basic(t *testing.T) {
 				Config: DataSourceCivoFirewallConfig(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(datasourceName, "name", name),
+					resource.TestCheckResourceAttrPair(datasourceName, "ingress_rule.#", "civo_firewall.foobar", "ingress_rule.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "egress_rule.#", "civo_firewall.foobar", "egress_rule.#"),
 				),
 			},
 		},
@@ -0,0 +1,172 @@
+package firewall
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// firewallUsage is a firewall together with the instances and clusters using it
+type firewallUsage struct {
+	civogo.Firewall
+	InstanceIDs []string
+	ClusterIDs  []string
+}
+
+// DataSourceFirewalls Data source to get and filter all firewalls with filter
+func DataSourceFirewalls() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on the firewalls of a region, with the ability to filter and sort the results. If no filters are specified, all firewalls of the region will be returned.",
+			"The rules of every firewall and the instances and clusters using it are exported, so the firewalls can be audited, for example to find the firewalls opening port 22 to `0.0.0.0/0`.",
+			"Note: You can use the `civo_firewall` data source to obtain metadata about a single firewall if you already know the id or name to retrieve.",
+		}, "\n\n"),
+		RecordSchema: firewallsSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all firewalls will be from the provided region",
+			},
+		},
+		ResultAttributeName: "firewalls",
+		FlattenRecord:       flattenDataSourceFirewalls,
+		GetRecords:          getDataSourceFirewalls,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceFirewalls(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
+
+	partialFirewalls, err := apiClient.ListFirewalls()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving firewalls: %s", err)
+	}
+
+	// the firewalls only have the number of instances and clusters using them, their IDs are read from the
+	// instances and the clusters of the region
+	instances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the instances: %s", err)
+	}
+	clusters, err := apiClient.ListKubernetesClusters()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the kubernetes clusters: %s", err)
+	}
+
+	instanceIDs := map[string][]string{}
+	for _, instance := range instances {
+		instanceIDs[instance.FirewallID] = append(instanceIDs[instance.FirewallID], instance.ID)
+	}
+	clusterIDs := map[string][]string{}
+	for _, cluster := range clusters.Items {
+		clusterIDs[cluster.FirewallID] = append(clusterIDs[cluster.FirewallID], cluster.ID)
+	}
+
+	var firewalls []interface{}
+	for _, partialFirewall := range partialFirewalls {
+		firewalls = append(firewalls, firewallUsage{
+			Firewall:    partialFirewall,
+			InstanceIDs: instanceIDs[partialFirewall.ID],
+			ClusterIDs:  clusterIDs[partialFirewall.ID],
+		})
+	}
+
+	return firewalls, nil
+}
+
+func flattenDataSourceFirewalls(firewall, m interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+	// the firewalls are listed in the region of the provider when the data source doesn't declare one
+	region = utils.ClientForRegion(m, region).Region
+
+	f := firewall.(firewallUsage)
+
+	flattenedFirewall := map[string]interface{}{}
+	flattenedFirewall["id"] = f.ID
+	flattenedFirewall["name"] = f.Name
+	flattenedFirewall["network_id"] = f.NetworkID
+	flattenedFirewall["region"] = region
+	flattenedFirewall["rules_count"] = len(f.Rules)
+	flattenedFirewall["instance_count"] = f.InstanceCount
+	flattenedFirewall["cluster_count"] = f.ClusterCount
+	flattenedFirewall["loadbalancer_count"] = f.LoadBalancerCount
+	flattenedFirewall["instance_ids"] = f.InstanceIDs
+	flattenedFirewall["cluster_ids"] = f.ClusterIDs
+	flattenedFirewall["ingress_rule"] = flattenFirewallRules(f.Rules, "ingress")
+	flattenedFirewall["egress_rule"] = flattenFirewallRules(f.Rules, "egress")
+
+	return flattenedFirewall, nil
+}
+
+func firewallsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the firewall",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the firewall",
+		},
+		"network_id": {
+			Type:        schema.TypeString,
+			Description: "The id of the associated network",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "The region of the firewall",
+		},
+		"rules_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of rules of the firewall",
+		},
+		"instance_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of instances using the firewall",
+		},
+		"cluster_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of Kubernetes clusters using the firewall",
+		},
+		"loadbalancer_count": {
+			Type:        schema.TypeInt,
+			Description: "The number of load balancers using the firewall",
+		},
+		"instance_ids": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The IDs of the instances using the firewall, including the nodes of the Kubernetes clusters",
+		},
+		"cluster_ids": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The IDs of the Kubernetes clusters using the firewall",
+		},
+		"ingress_rule": {
+			Type:        schema.TypeList,
+			Elem:        dataSourceFirewallRuleSchema(),
+			Description: "The ingress rules of the firewall",
+		},
+		"egress_rule": {
+			Type:        schema.TypeList,
+			Elem:        dataSourceFirewallRuleSchema(),
+			Description: "The egress rules of the firewall",
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package firewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoFirewalls_basic(t *testing.T) {
+	datasourceName := "data.civo_firewalls.foobar"
+	name := acctest.RandomWithPrefix("fw-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoFirewallsConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "firewalls.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "firewalls.0.name", name),
+					resource.TestCheckResourceAttrPair(datasourceName, "firewalls.0.id", "civo_firewall.foobar", "id"),
+					resource.TestCheckResourceAttr(datasourceName, "firewalls.0.ingress_rule.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "firewalls.0.ingress_rule.0.port_range", "22"),
+					resource.TestCheckResourceAttr(datasourceName, "firewalls.0.instance_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoFirewallsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "foobar" {
+	name = "%s"
+	region = "LON1"
+
+	ingress_rule {
+		label = "ssh"
+		protocol = "tcp"
+		port_range = "22"
+		cidr = ["0.0.0.0/0"]
+		action = "allow"
+	}
+}
+
+data "civo_firewalls" "foobar" {
+	region = "LON1"
+	filter {
+		key = "name"
+		values = [civo_firewall.foobar.name]
+	}
+}
+`, name)
+}
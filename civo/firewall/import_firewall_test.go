@@ -0,0 +1,33 @@
+package firewall_test
+
+import (
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCivoFirewall_importBasic(t *testing.T) {
+	resourceName := "civo_firewall.foobar"
+	firewallName := acctest.RandomWithPrefix("tf-fw")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoFirewallConfigDefaultRulesIngress(firewallName),
+			},
+			{
+				// the rules of the firewall are imported together with it
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the region of the firewall isn't part of its ID, it's imported in the region of the provider
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+		},
+	})
+}
@@ -3,11 +3,11 @@ package firewall
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -40,72 +40,47 @@ func ResourceFirewall() *schema.Resource {
 				Description: "The firewall region, if is not defined we use the global defined in the provider",
 			},
 			"create_default_rules": {
-				Type:        schema.TypeBool,
-				Default:     true,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The create rules flag is used to create the default firewall rules, if is not defined will be set to true, and if you set to false you need to define at least one ingress or egress rule",
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+				ForceNew: true,
+				Description: "The create rules flag is used to create the default firewall rules, if is not defined will be set to true, and if you set to false you need to define at least one ingress or egress rule. " +
+					"The default rules are shown in `ingress_rule` and `egress_rule`, declaring rules of a direction replaces the default rules of that direction",
 			},
 			"ingress_rule": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				Elem:        firewallRuleSchema(),
-				Description: "The ingress rules, this is a list of rules that will be applied to the firewall",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     firewallRuleSchema("ingress"),
+				Description: "The ingress rules, this is a list of rules that will be applied to the firewall. The ingress rules of the firewall are reconciled with the configured rules: missing rules are created and the rules that aren't configured are deleted." +
+					" Don't declare ingress rules for a firewall used by a `civo_kubernetes_cluster` with `api_access_cidrs`, as the cluster replaces the rules for the port 6443 and the two would keep changing them, declare the rule for the port here instead",
 			},
 			"egress_rule": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
-				Elem:        firewallRuleSchema(),
-				Description: "The egress rules, this is a list of rules that will be applied to the firewall",
+				Elem:        firewallRuleSchema("egress"),
+				Description: "The egress rules, this is a list of rules that will be applied to the firewall. The egress rules of the firewall are reconciled with the configured rules: missing rules are created and the rules that aren't configured are deleted",
 			},
 		},
 		CreateContext: resourceFirewallCreate,
 		ReadContext:   resourceFirewallRead,
 		UpdateContext: resourceFirewallUpdate,
 		DeleteContext: resourceFirewallDelete,
-		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
-
-			ingressRules := diff.Get("ingress_rule")
-			egressRules := diff.Get("egress_rule")
-
-			for _, v := range ingressRules.(*schema.Set).List() {
-				ingress := v.(map[string]interface{})
-				protocol := ingress["protocol"]
-
-				port := ingress["port_range"]
-				if protocol != "icmp" && port == "" {
-					return fmt.Errorf("`ports` of ingress rules is required if protocol is `tcp` or `udp`")
-				}
-			}
-
-			for _, v := range egressRules.(*schema.Set).List() {
-				egress := v.(map[string]interface{})
-				protocol := egress["protocol"]
-
-				port := egress["port_range"]
-				if protocol != "icmp" && port == "" {
-					return fmt.Errorf("`ports` of egress rules is required if protocol is `tcp` or `udp`")
-				}
-			}
-
-			return nil
-		},
+		CustomizeDiff: firewallRulesCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceFirewallImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 	}
 }
 
 // function to create a firewall
 func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	createDefaultRules := d.Get("create_default_rules").(bool)
 
@@ -125,7 +100,7 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	log.Printf("[INFO] creating a new firewall %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("creating a new firewall %s", d.Get("name").(string)))
 
 	firewallConfig, err := firewallRequestBuild(d, apiClient)
 	if err != nil {
@@ -143,7 +118,7 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m inter
 			}
 			return resp, string(resp.Result), nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 10,
@@ -161,24 +136,28 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	d.SetId(firewall.ID)
 
+	// the declared rules are the only rules of their direction, the default rules are only kept for the
+	// directions without rules. When the default rules aren't created, the API may still add rules which are removed.
+	for _, direction := range []string{"ingress", "egress"} {
+		if !createDefaultRules || rulesConfigured(d, direction) {
+			if err := reconcileFirewallRules(ctx, apiClient, d, direction); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceFirewallRead(ctx, d, m)
 }
 
 // function to read a firewall
-func resourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retriving the firewall %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the firewall %s", d.Id()))
 	resp, err := apiClient.FindFirewall(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "firewall")
 		}
 		return diag.Errorf("[ERR] error retrieving firewall: %s", err)
 	}
@@ -188,36 +167,36 @@ func resourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface
 	d.Set("region", apiClient.Region)
 	d.Set("create_default_rules", d.Get("create_default_rules").(bool))
 
-	for _, rule := range resp.Rules {
-		if rule.Direction == "ingress" {
-			if err := d.Set("ingress_rule", flattenFirewallRules(resp.Rules, rule.Direction)); err != nil {
-				return diag.Errorf("[ERR] error setting ingress rules: %s", err)
-			}
-		} else {
-			if err := d.Set("egress_rule", flattenFirewallRules(resp.Rules, rule.Direction)); err != nil {
-				return diag.Errorf("[ERR] error setting egress rules: %s", err)
-			}
-		}
+	tflog.Debug(ctx, fmt.Sprintf("retrieved %d rules of the firewall %s", len(resp.Rules), d.Id()))
+	// the rules are always set, so the rules deleted outside of terraform are created again
+	if err := d.Set("ingress_rule", flattenFirewallRules(resp.Rules, "ingress")); err != nil {
+		return diag.Errorf("[ERR] error setting ingress rules: %s", err)
+	}
+	if err := d.Set("egress_rule", flattenFirewallRules(resp.Rules, "egress")); err != nil {
+		return diag.Errorf("[ERR] error setting egress rules: %s", err)
 	}
 
 	return nil
 }
 
+// resourceFirewallImport imports the firewall with all of its rules, they are read into `ingress_rule` and
+// `egress_rule`. The default of `create_default_rules` is set as it can't be read and changing it replaces the firewall.
+func resourceFirewallImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("create_default_rules", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // function to update the firewall
 func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	if d.HasChange("name") {
 		if d.Get("name").(string) != "" {
 			firewall := civogo.FirewallConfig{
 				Name: d.Get("name").(string),
 			}
-			log.Printf("[INFO] updating the firewall name, %s", d.Id())
+			tflog.Info(ctx, fmt.Sprintf("updating the firewall name, %s", d.Id()))
 			_, err := apiClient.RenameFirewall(d.Id(), &firewall)
 			if err != nil {
 				return diag.Errorf("[WARN] an error occurred while trying to rename the firewall %s, %s", d.Id(), err)
@@ -225,70 +204,10 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
-	if d.HasChange("ingress_rule") || d.HasChange("egress_rule") {
-		var ingressRules []interface{}
-		var egressRules []interface{}
-
-		if value, ok := d.GetOk("ingress_rule"); ok {
-			ingressRules = value.(*schema.Set).List()
-		}
-
-		if value, ok := d.GetOk("egress_rule"); ok {
-			egressRules = value.(*schema.Set).List()
-		}
-
-		// call the api to get the current rules
-		allRules, err := apiClient.ListFirewallRules(d.Id())
-		if err != nil {
-			return diag.Errorf("[ERR] an error occurred while trying to list the firewall rules, %s", err)
-		}
-
-		// remove the rules that are not in terraform
-		for _, rule := range allRules {
-			if rule.Direction == "ingress" {
-				if !ingressRulesContains(ingressRules, rule) {
-					log.Printf("[INFO] removing the ingress rule %s", rule.ID)
-					_, err := apiClient.DeleteFirewallRule(d.Id(), rule.ID)
-					if err != nil {
-						return diag.Errorf("[WARN] an error occurred while trying to delete the ingress rule %s, %s", rule.ID, err)
-					}
-				}
-			} else {
-				if !egressRulesContains(egressRules, rule) {
-					log.Printf("[INFO] removing the egress rule %s", rule.ID)
-					_, err := apiClient.DeleteFirewallRule(d.Id(), rule.ID)
-					if err != nil {
-						return diag.Errorf("[WARN] an error occurred while trying to delete the egress rule %s, %s", rule.ID, err)
-					}
-				}
-			}
-		}
-
-		// add the new ingressRules that are not in the current rules
-		if len(ingressRules) > 0 {
-			for _, ingressRule := range ingressRules {
-				if ingressRule.(map[string]interface{})["id"] == "" {
-					fwRule := firewallUpdateBuild(ingressRule, apiClient.Region, "ingress", d)
-					resp, err := apiClient.NewFirewallRule(fwRule)
-					if err != nil {
-						return diag.Errorf("[WARN] an error occurred while trying to create the ingress rule %s, %s", fwRule, err)
-					}
-					log.Printf("[INFO] creating a new ingress rule %s", resp.ID)
-				}
-			}
-		}
-
-		// add the new egressRules that are not in the current rules
-		if len(egressRules) > 0 {
-			for _, egressRule := range egressRules {
-				if egressRule.(map[string]interface{})["id"] == "" {
-					fwRule := firewallUpdateBuild(egressRule, apiClient.Region, "egress", d)
-					resp, err := apiClient.NewFirewallRule(fwRule)
-					if err != nil {
-						return diag.Errorf("[WARN] an error occurred while trying to create the egress rule %s, %s", fwRule, err)
-					}
-					log.Printf("[INFO] creating a new egress rule %s", resp.ID)
-				}
+	for _, direction := range []string{"ingress", "egress"} {
+		if d.HasChange(direction + "_rule") {
+			if err := reconcileFirewallRules(ctx, apiClient, d, direction); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -297,23 +216,18 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 // function to delete a firewall
-func resourceFirewallDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	firewallID := d.Id()
-	log.Printf("[INFO] Checking if firewall %s exists", firewallID)
+	tflog.Info(ctx, fmt.Sprintf("Checking if firewall %s exists", firewallID))
 	_, err := apiClient.FindFirewall(firewallID)
 	if err != nil {
-		log.Printf("[INFO] Unable to find firewall %s - probably it's been deleted", firewallID)
+		tflog.Info(ctx, fmt.Sprintf("Unable to find firewall %s - probably it's been deleted", firewallID))
 		return nil
 	}
 
-	log.Printf("[INFO] deleting the firewall %s", firewallID)
+	tflog.Info(ctx, fmt.Sprintf("deleting the firewall %s", firewallID))
 
 	deleteStateConf := &retry.StateChangeConf{
 		Pending: []string{"failed"},
@@ -325,7 +239,7 @@ func resourceFirewallDelete(_ context.Context, d *schema.ResourceData, m interfa
 			}
 			return resp, string(resp.Result), nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutDelete),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 10,
@@ -338,27 +252,14 @@ func resourceFirewallDelete(_ context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
-// ingressRulesContains check if the ingress rules contains the rule
-func ingressRulesContains(ingressRules []interface{}, rule civogo.FirewallRule) bool {
-	for _, ingressRule := range ingressRules {
-		if ingressRule.(map[string]interface{})["id"] == rule.ID {
-			return true
-		}
-	}
-	return false
-}
-
-// egressRulesContains check if the egress rules contains the rule
-func egressRulesContains(egressRules []interface{}, rule civogo.FirewallRule) bool {
-	for _, egressRule := range egressRules {
-		if egressRule.(map[string]interface{})["id"] == rule.ID {
-			return true
-		}
+// firewallRuleSchema is the schema of the rules of a direction, ingress and egress rules have the same fields
+// but the CIDRs of ingress rules are the sources of the traffic and the CIDRs of egress rules its destinations
+func firewallRuleSchema(direction string) *schema.Resource {
+	cidrDescription := "The CIDR notation of the sources of the traffic, or a valid network CIDR (e.g. 0.0.0.0/0 to open for everyone or 1.2.3.4/32 to open just for a specific IP address). IPv6 CIDRs are supported too, e.g. ::/0 to open for everyone over IPv6"
+	if direction == "egress" {
+		cidrDescription = "The CIDR notation of the destinations of the traffic, or a valid network CIDR (e.g. 0.0.0.0/0 to allow traffic to everywhere or 1.2.3.4/32 to allow it just to a specific IP address). IPv6 CIDRs are supported too, e.g. ::/0 to allow traffic to everywhere over IPv6"
 	}
-	return false
-}
 
-func firewallRuleSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -390,16 +291,16 @@ func firewallRuleSchema() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				// Computed:     true,
-				Description:  "The port or port range to open, can be a single port or a range separated by a dash (`-`), e.g. `80` or `80-443`",
-				ValidateFunc: validation.NoZeroValues,
+				Description:  "The port or port range to open, can be a single port or a range separated by a dash (`-`), e.g. `80` or `80-443`, the ports have to be between 1 and 65535. It's required for `tcp` and `udp` rules and can't be set for `icmp` rules",
+				ValidateFunc: validatePortRange,
 			},
 			"cidr": {
 				Type:        schema.TypeSet,
 				Required:    true,
-				Description: "The CIDR notation of the other end to affect, or a valid network CIDR (e.g. 0.0.0.0/0 to open for everyone or 1.2.3.4/32 to open just for a specific IP address)",
+				Description: cidrDescription,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.NoZeroValues,
+					ValidateFunc: validateRuleCIDR,
 				},
 			},
 			"action": {
@@ -499,22 +400,18 @@ func flattenFirewallRules(rules []civogo.FirewallRule, direction string) []inter
 		}
 	}
 
-	log.Printf("[INFO] retriving the firewall rules %+v", rulesObject)
-
 	flattenedRules := make([]interface{}, rulesCount)
 	for i, rule := range rulesObject {
 		flattenedRules[i] = map[string]interface{}{
 			"id":         rule.ID,
 			"label":      rule.Label,
 			"protocol":   rule.Protocol,
-			"port_range": rule.Ports,
+			"port_range": firewallRulePorts(rule),
 			"action":     rule.Action,
 			"cidr":       flattenFirewallRuleCIDR(rule.Cidr),
 		}
 	}
 
-	log.Printf("[INFO] retriving the flattenedRules %+v", flattenedRules)
-
 	return flattenedRules
 }
 
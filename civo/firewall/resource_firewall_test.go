@@ -2,10 +2,12 @@ package firewall_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -79,6 +81,113 @@ func TestAccCivoFirewallWithIngressEgress_basic(t *testing.T) {
 	})
 }
 
+func TestAccCivoFirewallRules_update(t *testing.T) {
+	var firewall civogo.Firewall
+
+	// generate a random name for each test run
+	resName := "civo_firewall.foobar"
+	var firewallName = acctest.RandomWithPrefix("tf-fw")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoFirewallConfigWithIngressEgress(firewallName),
+				Check: resource.ComposeTestCheckFunc(
+					CivoFirewallResourceExists(resName, &firewall),
+					resource.TestCheckResourceAttr(resName, "ingress_rule.#", "1"),
+				),
+			},
+			{
+				// the changed rule is replaced and the new rule is added, the egress rule is left as it is
+				Config: CivoFirewallConfigRulesUpdates(firewallName),
+				Check: resource.ComposeTestCheckFunc(
+					CivoFirewallResourceExists(resName, &firewall),
+					resource.TestCheckResourceAttr(resName, "ingress_rule.#", "2"),
+					resource.TestCheckResourceAttr(resName, "egress_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resName, "ingress_rule.*", map[string]string{
+						"label":      "www https",
+						"port_range": "8443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resName, "ingress_rule.*", map[string]string{
+						"label":      "www http",
+						"port_range": "80",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoFirewallDefaultRules_replaced(t *testing.T) {
+	var firewall civogo.Firewall
+
+	// generate a random name for each test run
+	resName := "civo_firewall.foobar"
+	var firewallName = acctest.RandomWithPrefix("tf-fw")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the declared ingress rule replaces the default ingress rules, the default egress rules are kept
+				Config: CivoFirewallConfigDefaultRulesIngress(firewallName),
+				Check: resource.ComposeTestCheckFunc(
+					CivoFirewallResourceExists(resName, &firewall),
+					resource.TestCheckResourceAttr(resName, "ingress_rule.#", "1"),
+					resource.TestCheckResourceAttr(resName, "ingress_rule.0.port_range", "443"),
+					resource.TestCheckResourceAttrSet(resName, "egress_rule.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoFirewallRules_invalid(t *testing.T) {
+	var firewallName = acctest.RandomWithPrefix("tf-fw")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      CivoFirewallConfigRule(firewallName, "443-80", "0.0.0.0/0"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("to be lower than the end port"),
+			},
+			{
+				Config:      CivoFirewallConfigRule(firewallName, "70000", "0.0.0.0/0"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("to be between 1 and 65535"),
+			},
+			{
+				Config:      CivoFirewallConfigRuleProtocol(firewallName, "egress", "icmp", "53"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("can't be set as the protocol is `icmp`"),
+			},
+			{
+				Config:      CivoFirewallConfigRuleProtocol(firewallName, "egress", "udp", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`port_range` of egress rules is required"),
+			},
+			{
+				Config:      CivoFirewallConfigRule(firewallName, "443", "10.0.0.300/24"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("to be a valid CIDR Value"),
+			},
+			{
+				Config:      CivoFirewallConfigRule(firewallName, "443", "2001:DB8:0::/32"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("to be written as 2001:db8::/32"),
+			},
+		},
+	})
+}
+
 func TestAccCivoFirewall_update(t *testing.T) {
 	var firewall civogo.Firewall
 
@@ -132,7 +241,7 @@ func CivoFirewallResourceExists(n string, firewall *civogo.Firewall) resource.Te
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.FindFirewall(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Firewall not found: (%s) %s", rs.Primary.ID, err)
@@ -156,7 +265,7 @@ func CivoFirewallUpdated(firewall *civogo.Firewall, name string) resource.TestCh
 }
 
 func CivoFirewallDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_firewall" {
@@ -214,3 +323,90 @@ resource "civo_firewall" "foobar" {
 	region = "LOCAL"
 }`, name)
 }
+
+func CivoFirewallConfigRulesUpdates(name string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "foobar" {
+	name = "%s"
+	create_default_rules = false
+	region = "LOCAL"
+
+	ingress_rule {
+		label = "www https"
+		protocol = "tcp"
+		port_range = "8443"
+		cidr = ["192.168.1.1/32", "192.168.10.4/32"]
+		action = "allow"
+	}
+
+	ingress_rule {
+		label = "www http"
+		protocol = "tcp"
+		port_range = "80"
+		cidr = ["0.0.0.0/0"]
+		action = "allow"
+	}
+
+	egress_rule {
+		label = "ssh"
+		protocol = "tcp"
+		port_range = "22"
+		cidr = ["192.168.1.1/32", "192.168.10.4/32", "192.168.10.10/32"]
+		action = "allow"
+	}
+}`, name)
+}
+
+func CivoFirewallConfigDefaultRulesIngress(name string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "foobar" {
+	name = "%s"
+	create_default_rules = true
+	region = "LOCAL"
+
+	ingress_rule {
+		label = "www https"
+		protocol = "tcp"
+		port_range = "443"
+		cidr = ["0.0.0.0/0"]
+		action = "allow"
+	}
+}`, name)
+}
+
+func CivoFirewallConfigRule(name, ports, cidr string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "foobar" {
+	name = "%s"
+	region = "LOCAL"
+
+	ingress_rule {
+		label = "www"
+		protocol = "tcp"
+		port_range = "%s"
+		cidr = ["%s"]
+		action = "allow"
+	}
+}`, name, ports, cidr)
+}
+
+func CivoFirewallConfigRuleProtocol(name, direction, protocol, ports string) string {
+	portRange := ""
+	if ports != "" {
+		portRange = fmt.Sprintf("port_range = %q", ports)
+	}
+
+	return fmt.Sprintf(`
+resource "civo_firewall" "foobar" {
+	name = "%s"
+	region = "LOCAL"
+
+	%s_rule {
+		label = "dns"
+		protocol = "%s"
+		%s
+		cidr = ["0.0.0.0/0"]
+		action = "allow"
+	}
+}`, name, direction, protocol, portRange)
+}
@@ -0,0 +1,194 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// firewallRulePorts returns the ports of the rule as set in `port_range`, the API returns either the ports
+// or the start and end ports of the rule
+func firewallRulePorts(rule civogo.FirewallRule) string {
+	if rule.Ports != "" {
+		return rule.Ports
+	}
+	if rule.StartPort == "" || rule.EndPort == "" || rule.StartPort == rule.EndPort {
+		return rule.StartPort
+	}
+
+	return fmt.Sprintf("%s-%s", rule.StartPort, rule.EndPort)
+}
+
+// normalizeCIDR returns the CIDR in the form the API returns it, IPv6 addresses can be written in several ways
+func normalizeCIDR(cidr string) string {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil || network.IP.To4() != nil {
+		return cidr
+	}
+
+	return network.String()
+}
+
+// firewallRuleKey identifies a rule by its content, the rules of the firewall are reconciled with the
+// configured rules by their key as the rules of a block don't keep their ID when they're changed
+func firewallRuleKey(direction, protocol, ports, action, label string, cidrs []string) string {
+	sortedCIDRs := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		sortedCIDRs = append(sortedCIDRs, normalizeCIDR(cidr))
+	}
+	sort.Strings(sortedCIDRs)
+
+	return strings.Join([]string{direction, strings.ToLower(protocol), ports, action, label, strings.Join(sortedCIDRs, ",")}, "|")
+}
+
+// reconcileFirewallRules makes the rules of the direction of the firewall match the configured rules: the
+// missing rules are created first and then the rules that aren't configured are deleted, so the firewall
+// never goes without the rules that are kept
+func reconcileFirewallRules(ctx context.Context, apiClient *civogo.Client, d *schema.ResourceData, direction string) error {
+	var configured []interface{}
+	if value, ok := d.GetOk(direction + "_rule"); ok {
+		configured = value.(*schema.Set).List()
+	}
+
+	rules, err := apiClient.ListFirewallRules(d.Id())
+	if err != nil {
+		return fmt.Errorf("[ERR] an error occurred while trying to list the firewall rules, %s", err)
+	}
+
+	// the rules of the firewall by key, a key can be used by several rules
+	existing := map[string][]civogo.FirewallRule{}
+	for _, rule := range rules {
+		if rule.Direction != direction {
+			continue
+		}
+		key := firewallRuleKey(direction, rule.Protocol, firewallRulePorts(rule), rule.Action, rule.Label, rule.Cidr)
+		existing[key] = append(existing[key], rule)
+	}
+
+	for _, object := range configured {
+		rule := firewallUpdateBuild(object, apiClient.Region, direction, d)
+		key := firewallRuleKey(direction, rule.Protocol, rule.Ports, rule.Action, rule.Label, rule.Cidr)
+		if len(existing[key]) > 0 {
+			// the rule is kept
+			existing[key] = existing[key][1:]
+			continue
+		}
+
+		resp, err := apiClient.NewFirewallRule(rule)
+		if err != nil {
+			return fmt.Errorf("[WARN] an error occurred while trying to create the %s rule %s, %s", direction, rule.Label, err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("creating a new %s rule %s", direction, resp.ID))
+	}
+
+	for _, remaining := range existing {
+		for _, rule := range remaining {
+			tflog.Info(ctx, fmt.Sprintf("removing the %s rule %s", direction, rule.ID))
+			if _, err := apiClient.DeleteFirewallRule(d.Id(), rule.ID); err != nil {
+				return fmt.Errorf("[WARN] an error occurred while trying to delete the %s rule %s, %s", direction, rule.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rulesConfigured reports whether rules of the direction are declared in the configuration, when they are they
+// replace the default rules of the direction created with the firewall
+func rulesConfigured(d *schema.ResourceData, direction string) bool {
+	rules := d.GetRawConfig().GetAttr(direction + "_rule")
+	return rules.IsKnown() && !rules.IsNull() && rules.LengthInt() > 0
+}
+
+// validatePortRange checks the ports of a rule are a port, a range of ports separated by a dash (`-`) with the
+// start port lower than the end port, or a comma separated list of them, all of them between 1 and 65535
+func validatePortRange(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected %s to be string", k)}
+	}
+
+	parsePort := func(port string) (int, error) {
+		p, err := strconv.Atoi(strings.TrimSpace(port))
+		if err != nil || p < 1 || p > 65535 {
+			return 0, fmt.Errorf("expected the ports of %s to be between 1 and 65535, got: %s", k, port)
+		}
+		return p, nil
+	}
+
+	for _, ports := range strings.Split(value, ",") {
+		start, end, isRange := strings.Cut(ports, "-")
+		startPort, err := parsePort(start)
+		if err != nil {
+			es = append(es, err)
+			continue
+		}
+		if !isRange {
+			continue
+		}
+
+		endPort, err := parsePort(end)
+		if err != nil {
+			es = append(es, err)
+			continue
+		}
+		if startPort > endPort {
+			es = append(es, fmt.Errorf("expected the start port of %s to be lower than the end port, got: %s", k, ports))
+		}
+	}
+
+	return ws, es
+}
+
+// firewallRulesCustomizeDiff checks the ports of the ingress and egress rules: `tcp` and `udp` rules need them
+// and `icmp` rules have no ports. Only the configured rules are checked, not the default rules read from the
+// firewall, and the rules whose ports are only known when applying are skipped.
+func firewallRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	for _, direction := range []string{"ingress", "egress"} {
+		if rules := config.GetAttr(direction + "_rule"); !d.NewValueKnown(direction+"_rule") || rules.IsNull() || !rules.IsKnown() {
+			continue
+		}
+
+		for _, v := range d.Get(direction + "_rule").(*schema.Set).List() {
+			rule := v.(map[string]interface{})
+			protocol, ports := rule["protocol"].(string), rule["port_range"].(string)
+
+			if protocol == "icmp" && ports != "" {
+				return fmt.Errorf("[ERR] `port_range` of the %s rule %q can't be set as the protocol is `icmp`", direction, rule["label"])
+			}
+			if protocol != "icmp" && ports == "" {
+				return fmt.Errorf("[ERR] `port_range` of %s rules is required if protocol is `tcp` or `udp`", direction)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRuleCIDR checks the CIDR of a rule is an IPv4 or IPv6 CIDR. The IPv6 CIDRs have to be written in their
+// canonical form, lowercase and compressed without host bits, so they match the rules read back from the API.
+func validateRuleCIDR(v interface{}, k string) (ws []string, es []error) {
+	ws, es = validation.IsCIDR(v, k)
+	if len(es) > 0 {
+		return ws, es
+	}
+
+	if cidr := v.(string); normalizeCIDR(cidr) != cidr {
+		es = append(es, fmt.Errorf("expected %s to be written as %s, got: %s", k, normalizeCIDR(cidr), cidr))
+	}
+
+	return ws, es
+}
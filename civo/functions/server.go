@@ -0,0 +1,158 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// function is a provider-defined function returning a number computed from a single string argument
+type function struct {
+	definition *tfprotov5.Function
+	call       func(string) (int, error)
+}
+
+// sizeFunction returns a function reading one of the resources of a Civo size
+func sizeFunction(summary, description string, value func(sizeSpec) int) function {
+	return function{
+		definition: &tfprotov5.Function{
+			Summary:     summary,
+			Description: description + ". Only the sizes listed for the argument are known, use the `civo_size` data source for the other sizes",
+			Parameters: []*tfprotov5.FunctionParameter{
+				{
+					Name:        "size",
+					Type:        tftypes.String,
+					Description: "The name of the size, one of " + knownSizeNames(),
+				},
+			},
+			Return: &tfprotov5.FunctionReturn{
+				Type: tftypes.Number,
+			},
+		},
+		call: func(name string) (int, error) {
+			size, err := lookupSize(name)
+			if err != nil {
+				return 0, err
+			}
+			return value(size), nil
+		},
+	}
+}
+
+// providerFunctions are the functions served by the provider, referenced as provider::civo::<name>
+var providerFunctions = map[string]function{
+	"size_cpu": sizeFunction("Number of CPU cores of a size", "Returns the number of CPU cores of a Civo instance or Kubernetes node size",
+		func(s sizeSpec) int { return s.CPU }),
+	"size_ram": sizeFunction("RAM of a size in MB", "Returns the RAM in megabytes of a Civo instance or Kubernetes node size",
+		func(s sizeSpec) int { return s.RAMMB }),
+	"size_disk": sizeFunction("Disk of a size in GB", "Returns the disk size in gigabytes of a Civo instance or Kubernetes node size",
+		func(s sizeSpec) int { return s.DiskGB }),
+}
+
+// providerServer adds the provider-defined functions to a provider server, SDKv2 doesn't support them
+type providerServer struct {
+	tfprotov5.ProviderServer
+}
+
+// NewProviderServer wraps the SDKv2 provider server so it also serves the provider-defined functions
+func NewProviderServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &providerServer{ProviderServer: server}
+}
+
+// GetMetadata adds the functions to the metadata of the wrapped provider
+func (s *providerServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.ProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	names := make([]string, 0, len(providerFunctions))
+	for name := range providerFunctions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resp.Functions = append(resp.Functions, tfprotov5.FunctionMetadata{Name: name})
+	}
+
+	return resp, nil
+}
+
+// GetProviderSchema adds the functions to the schema of the wrapped provider
+func (s *providerServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Functions = definitions()
+	return resp, nil
+}
+
+// GetFunctions returns the definition of every function
+func (s *providerServer) GetFunctions(_ context.Context, _ *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{
+		Functions: definitions(),
+	}, nil
+}
+
+// CallFunction runs a function with the arguments sent by Terraform
+func (s *providerServer) CallFunction(_ context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	fn, ok := providerFunctions[req.Name]
+	if !ok {
+		return functionError("Function Not Found", fmt.Sprintf("No function named %q was found in the provider.", req.Name)), nil
+	}
+
+	if len(req.Arguments) != 1 {
+		return functionError("Invalid Arguments", fmt.Sprintf("The function %q expects 1 argument, got %d.", req.Name, len(req.Arguments))), nil
+	}
+
+	argument, err := req.Arguments[0].Unmarshal(tftypes.String)
+	if err != nil {
+		return functionError("Invalid Argument", fmt.Sprintf("Unable to read the argument of %q: %s", req.Name, err)), nil
+	}
+
+	var name string
+	if err := argument.As(&name); err != nil {
+		return functionError("Invalid Argument", fmt.Sprintf("The argument of %q must be a string: %s", req.Name, err)), nil
+	}
+
+	value, err := fn.call(name)
+	if err != nil {
+		return functionError("Invalid Size", err.Error()), nil
+	}
+
+	result, err := tfprotov5.NewDynamicValue(tftypes.Number, tftypes.NewValue(tftypes.Number, big.NewFloat(float64(value))))
+	if err != nil {
+		return functionError("Invalid Result", fmt.Sprintf("Unable to encode the result of %q: %s", req.Name, err)), nil
+	}
+
+	return &tfprotov5.CallFunctionResponse{
+		Result: &result,
+	}, nil
+}
+
+func definitions() map[string]*tfprotov5.Function {
+	functions := make(map[string]*tfprotov5.Function, len(providerFunctions))
+	for name, fn := range providerFunctions {
+		functions[name] = fn.definition
+	}
+
+	return functions
+}
+
+func functionError(summary, detail string) *tfprotov5.CallFunctionResponse {
+	return &tfprotov5.CallFunctionResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  summary,
+				Detail:   detail,
+			},
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package functions_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo"
+	"github.com/civo/terraform-provider-civo/civo/functions"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func callSizeFunction(t *testing.T, name, size string) *tfprotov5.CallFunctionResponse {
+	server := functions.NewProviderServer(schema.NewGRPCProviderServer(civo.Provider()))
+
+	argument, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, size))
+	if err != nil {
+		t.Fatalf("unable to encode the argument: %s", err)
+	}
+
+	resp, err := server.(tfprotov5.FunctionServer).CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+		Name:      name,
+		Arguments: []*tfprotov5.DynamicValue{&argument},
+	})
+	if err != nil {
+		t.Fatalf("unable to call %s: %s", name, err)
+	}
+
+	return resp
+}
+
+// TestSizeFunctions tests the size_cpu, size_ram and size_disk functions
+func TestSizeFunctions(t *testing.T) {
+	expected := map[string]int64{
+		"size_cpu":  2,
+		"size_ram":  4096,
+		"size_disk": 50,
+	}
+
+	for name, want := range expected {
+		resp := callSizeFunction(t, name, "g4s.kube.medium")
+		if len(resp.Diagnostics) > 0 {
+			t.Fatalf("%s returned an error: %s", name, resp.Diagnostics[0].Detail)
+		}
+
+		result, err := resp.Result.Unmarshal(tftypes.Number)
+		if err != nil {
+			t.Fatalf("unable to decode the result of %s: %s", name, err)
+		}
+
+		var value big.Float
+		if err := result.As(&value); err != nil {
+			t.Fatalf("unable to read the result of %s: %s", name, err)
+		}
+		if got, _ := value.Int64(); got != want {
+			t.Fatalf("expected %s to return %d, got %d", name, want, got)
+		}
+	}
+}
+
+// TestSizeFunctionsUnknownSize tests the functions return an error for sizes they don't know
+func TestSizeFunctionsUnknownSize(t *testing.T) {
+	resp := callSizeFunction(t, "size_cpu", "g9.unknown")
+	if len(resp.Diagnostics) == 0 {
+		t.Fatal("expected an error for an unknown size")
+	}
+	if !strings.Contains(resp.Diagnostics[0].Detail, "`g4s.kube.medium`") {
+		t.Fatalf("expected the error to list the known sizes, got: %s", resp.Diagnostics[0].Detail)
+	}
+}
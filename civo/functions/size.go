@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// sizeSpec are the resources of a Civo size
+type sizeSpec struct {
+	CPU    int
+	RAMMB  int
+	DiskGB int
+}
+
+// knownSizes are the Civo instance and Kubernetes node sizes the functions know about, functions are
+// called without a configured provider so they can't ask the sizes API. The other sizes return an error
+// listing these ones.
+var knownSizes = map[string]sizeSpec{
+	"g3.xsmall":  {CPU: 1, RAMMB: 1024, DiskGB: 25},
+	"g3.small":   {CPU: 1, RAMMB: 2048, DiskGB: 25},
+	"g3.medium":  {CPU: 2, RAMMB: 4096, DiskGB: 50},
+	"g3.large":   {CPU: 4, RAMMB: 8192, DiskGB: 100},
+	"g3.xlarge":  {CPU: 6, RAMMB: 16384, DiskGB: 150},
+	"g3.2xlarge": {CPU: 8, RAMMB: 32768, DiskGB: 200},
+
+	"g4s.kube.xsmall": {CPU: 1, RAMMB: 1024, DiskGB: 30},
+	"g4s.kube.small":  {CPU: 1, RAMMB: 2048, DiskGB: 40},
+	"g4s.kube.medium": {CPU: 2, RAMMB: 4096, DiskGB: 50},
+	"g4s.kube.large":  {CPU: 4, RAMMB: 8192, DiskGB: 60},
+}
+
+// knownSizeNames returns the names of the known sizes, sorted and quoted for the docs and the errors
+func knownSizeNames() string {
+	names := make([]string, 0, len(knownSizes))
+	for name := range knownSizes {
+		names = append(names, "`"+name+"`")
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
+// lookupSize returns the resources of the size with the given name
+func lookupSize(name string) (sizeSpec, error) {
+	size, ok := knownSizes[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return sizeSpec{}, fmt.Errorf("unknown size %q, the functions only know the sizes %s. Use the civo_size data source to look up the other sizes available in your region", name, knownSizeNames())
+	}
+
+	return size, nil
+}
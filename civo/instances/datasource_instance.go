@@ -2,10 +2,12 @@ package instances
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +19,7 @@ func DataSourceInstance() *schema.Resource {
 	return &schema.Resource{
 		Description: strings.Join([]string{
 			"Get information on an instance for use in other resources. This data source provides all of the instance's properties as configured on your Civo account.",
-			"Note: This data source returns a single instance. When specifying a hostname, an error will be raised if more than one instances found.",
+			"Note: This data source returns a single instance. When specifying a hostname or a tag, an error will be raised if more than one instances found.",
 		}, "\n\n"),
 		ReadContext: dataSourceInstanceRead,
 		Schema: map[string]*schema.Schema{
@@ -25,15 +27,22 @@ func DataSourceInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "hostname"},
+				ExactlyOneOf: []string{"id", "hostname", "tag"},
 			},
 			"hostname": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "hostname"},
+				ExactlyOneOf: []string{"id", "hostname", "tag"},
 				Description:  "The hostname of the Instance",
 			},
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"id", "hostname", "tag"},
+				Description:  "A tag of the Instance, exactly one instance must have it",
+			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -122,6 +131,11 @@ func DataSourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "The public IP",
 			},
+			"public_ipv6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public IPv6 address, empty if the network of the instance doesn't have IPv6 enabled",
+			},
 			"pseudo_ip": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -141,18 +155,13 @@ func DataSourceInstance() *schema.Resource {
 	}
 }
 
-func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	var foundImage *civogo.Instance
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the instance by id")
+		tflog.Info(ctx, "Getting the instance by id")
 		image, err := apiClient.FindInstance(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrieve instance: %s", err)
@@ -160,10 +169,27 @@ func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interfa
 
 		foundImage = image
 	} else if hostname, ok := d.GetOk("hostname"); ok {
-		log.Printf("[INFO] Getting the instance by hostname")
-		image, err := apiClient.FindInstance(hostname.(string))
+		tflog.Info(ctx, "Getting the instance by hostname")
+		image, err := findInstanceBy(apiClient, "hostname", hostname.(string), func(instance civogo.Instance) bool {
+			return instance.Hostname == hostname.(string)
+		})
 		if err != nil {
-			return diag.Errorf("[ERR] failed to retrieve instance: %s", err)
+			return diag.FromErr(err)
+		}
+
+		foundImage = image
+	} else if tag, ok := d.GetOk("tag"); ok {
+		tflog.Info(ctx, "Getting the instance by tag")
+		image, err := findInstanceBy(apiClient, "tag", tag.(string), func(instance civogo.Instance) bool {
+			for _, t := range instance.Tags {
+				if t == tag.(string) {
+					return true
+				}
+			}
+			return false
+		})
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
 		foundImage = image
@@ -185,6 +211,7 @@ func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interfa
 	d.Set("tags", foundImage.Tags)
 	d.Set("private_ip", foundImage.PrivateIP)
 	d.Set("public_ip", foundImage.PublicIP)
+	d.Set("public_ipv6", foundImage.IPv6)
 	d.Set("pseudo_ip", foundImage.PseudoIP)
 	d.Set("status", foundImage.Status)
 	d.Set("region", apiClient.Region)
@@ -194,3 +221,31 @@ func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interfa
 
 	return nil
 }
+
+// findInstanceBy returns the only instance matching the lookup, listing the instances found when there are more than one
+func findInstanceBy(apiClient *civogo.Client, lookup, value string, match func(civogo.Instance) bool) (*civogo.Instance, error) {
+	instances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to retrieve instances: %s", err)
+	}
+
+	found := []civogo.Instance{}
+	for _, instance := range instances {
+		if match(instance) {
+			found = append(found, instance)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("[ERR] no instance found with the %s %q in the region %s", lookup, value, apiClient.Region)
+	case 1:
+		return &found[0], nil
+	default:
+		matches := make([]string, len(found))
+		for i, instance := range found {
+			matches[i] = fmt.Sprintf("%s (%s)", instance.Hostname, instance.ID)
+		}
+		return nil, fmt.Errorf("[ERR] %d instances found with the %s %q, use a more specific lookup: %s", len(found), lookup, value, strings.Join(matches, ", "))
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -34,37 +35,35 @@ func DataSourceInstances() *schema.Resource {
 }
 
 func getDataSourceInstances(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
-
 	// overwrite the region if is define in the datasource
 	region, ok := extra["region"].(string)
 	if !ok {
 		return nil, fmt.Errorf("unable to find `region` key from query data")
 	}
 
-	if region != "" {
-		apiClient.Region = region
-	}
+	apiClient := utils.ClientForRegion(m, region)
 
 	var instance []interface{}
-	partialInstances, err := apiClient.ListInstances(1, 200)
+	partialInstances, err := apiClient.ListAllInstances()
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving instances: %s", err)
 	}
 
-	for _, partialInstance := range partialInstances.Items {
+	for _, partialInstance := range partialInstances {
 		instance = append(instance, partialInstance)
 	}
 
 	return instance, nil
 }
 
-func flattenDataSourceInstances(instance, _ interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+func flattenDataSourceInstances(instance, m interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
 
 	region, ok := extra["region"].(string)
 	if !ok {
 		return nil, fmt.Errorf("unable to find `region` key from query data")
 	}
+	// the instances are listed in the region of the provider when the data source doesn't declare one
+	region = utils.ClientForRegion(m, region).Region
 
 	i := instance.(civogo.Instance)
 
@@ -86,8 +85,9 @@ func flattenDataSourceInstances(instance, _ interface{}, extra map[string]interf
 	flattenedInstance["tags"] = i.Tags
 	flattenedInstance["script"] = i.Script
 	flattenedInstance["initial_password"] = i.InitialPassword
-	flattenedInstance["private_ip"] = i.PublicIP
-	flattenedInstance["public_ip"] = i.PrivateIP
+	flattenedInstance["private_ip"] = i.PrivateIP
+	flattenedInstance["public_ip"] = i.PublicIP
+	flattenedInstance["public_ipv6"] = i.IPv6
 	flattenedInstance["pseudo_ip"] = i.PseudoIP
 	flattenedInstance["status"] = i.Status
 	flattenedInstance["created_at"] = i.CreatedAt.UTC().String()
@@ -174,6 +174,10 @@ func instancesSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Description: "Public IP of the instance",
 		},
+		"public_ipv6": {
+			Type:        schema.TypeString,
+			Description: "Public IPv6 address of the instance",
+		},
 		"pseudo_ip": {
 			Type:        schema.TypeString,
 			Description: "Pseudo IP of the instance",
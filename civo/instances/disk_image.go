@@ -0,0 +1,76 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// matchDiskImage returns the disk image whose ID or name is the search, or the only one containing it,
+// the same way the disk image is looked up when creating the instance. The names of the candidates are
+// returned when there is no single match.
+func matchDiskImage(images []civogo.DiskImage, search string) (*civogo.DiskImage, []string) {
+	partial := []civogo.DiskImage{}
+	for i, image := range images {
+		if image.ID == search || image.Name == search {
+			return &images[i], nil
+		}
+		if strings.Contains(image.Name, search) || strings.Contains(image.ID, search) {
+			partial = append(partial, image)
+		}
+	}
+	if len(partial) == 1 {
+		return &partial[0], nil
+	}
+
+	// suggest the partial matches, or every image if there are none
+	candidates := partial
+	if len(candidates) == 0 {
+		candidates = images
+	}
+	names := make([]string, len(candidates))
+	for i, image := range candidates {
+		names[i] = image.Name
+	}
+	sort.Strings(names)
+
+	return nil, names
+}
+
+// diskImageCustomizeDiff checks when planning that the `disk_image` of a new instance exists in its region,
+// so a typo fails the plan listing the available disk images instead of failing the apply
+func diskImageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	search, ok := d.Get("disk_image").(string)
+	if !ok || search == "" || !d.NewValueKnown("disk_image") || !d.NewValueKnown("region") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("disk_image") {
+		return nil
+	}
+
+	meta, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	images, err := utils.Cached(meta, "disk_images/"+apiClient.Region, apiClient.ListDiskImages)
+	if err != nil {
+		tflog.Warn(ctx, "unable to list the disk images to validate the disk image", map[string]interface{}{
+			"disk_image": search,
+			"error":      err.Error(),
+		})
+		return nil
+	}
+
+	if _, candidates := matchDiskImage(images, search); candidates != nil {
+		return fmt.Errorf("[ERR] the disk image %q doesn't match a single disk image in the region %s, candidates: %s", search, apiClient.Region, strings.Join(candidates, ", "))
+	}
+
+	return nil
+}
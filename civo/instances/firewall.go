@@ -0,0 +1,44 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// setInstanceFirewall moves the instance to another firewall without recreating it,
+// and waits until the instance reports the new firewall
+func setInstanceFirewall(ctx context.Context, apiClient *civogo.Client, id, firewallID string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("setting the firewall %s to the instance %s", firewallID, id))
+	_, err := apiClient.SetInstanceFirewall(id, firewallID)
+	if err != nil {
+		return fmt.Errorf("[ERR] an error occurred while setting the firewall %s to the instance %s: %s", firewallID, id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(id)
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.FirewallID != firewallID {
+				return resp, "PENDING", nil
+			}
+			return resp, "DONE", nil
+		},
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the firewall of the instance (%s) to be %s: %s", id, firewallID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// isKubernetesNode reports whether the instance is one of the nodes of the cluster,
+// either listed in the instances of the cluster or in the instances of one of its pools
+func isKubernetesNode(cluster civogo.KubernetesCluster, instance *civogo.Instance) bool {
+	matches := func(node civogo.KubernetesInstance) bool {
+		return node.ID == instance.ID || (node.Hostname != "" && node.Hostname == instance.Hostname)
+	}
+
+	for _, node := range cluster.Instances {
+		if matches(node) {
+			return true
+		}
+	}
+	for _, pool := range cluster.Pools {
+		for _, node := range pool.Instances {
+			if matches(node) {
+				return true
+			}
+		}
+		for _, name := range pool.InstanceNames {
+			if name == instance.Hostname {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// findKubernetesNodeCluster returns the kubernetes cluster the instance is a node of, or nil if it isn't part of a cluster
+func findKubernetesNodeCluster(apiClient *civogo.Client, instance *civogo.Instance) (*civogo.KubernetesCluster, error) {
+	clusters, err := apiClient.ListKubernetesClusters()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the kubernetes clusters: %s", err)
+	}
+
+	for _, cluster := range clusters.Items {
+		if isKubernetesNode(cluster, instance) {
+			return &cluster, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkNotKubernetesNode fails when the instance is a node of a kubernetes cluster. The provider can't cordon
+// and drain a node, so resizing or deleting it would take its workloads down with it. Instances that don't
+// exist anymore or aren't kubernetes nodes pass the check.
+func checkNotKubernetesNode(ctx context.Context, apiClient *civogo.Client, id string) error {
+	instance, err := apiClient.GetInstance(id)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("[ERR] failed to retrieve the instance %s: %s", id, err)
+	}
+
+	cluster, err := findKubernetesNodeCluster(apiClient, instance)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		tflog.Debug(ctx, fmt.Sprintf("the instance %s is not a kubernetes node", id))
+		return nil
+	}
+
+	return fmt.Errorf("[ERR] the instance %s is the node %s of the kubernetes cluster %s and it can't be drained by the provider, "+
+		"resize its node pool or recycle it with `civo_kubernetes_node_recycle` instead, or set `prevent_kubernetes_node_changes` to false to change it anyway", id, instance.Hostname, cluster.Name)
+}
@@ -0,0 +1,72 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// desiredStateRunning is the `desired_state` of an instance that is powered on
+	desiredStateRunning = "running"
+	// desiredStateStopped is the `desired_state` of an instance that is shut down
+	desiredStateStopped = "stopped"
+)
+
+// instanceDesiredState returns the `desired_state` matching the status of an instance,
+// or an empty string while the instance is changing between states
+func instanceDesiredState(status string) string {
+	switch status {
+	case "ACTIVE":
+		return desiredStateRunning
+	case "SHUTOFF":
+		return desiredStateStopped
+	default:
+		return ""
+	}
+}
+
+// setInstancePowerState stops or starts the instance so it is in the desired state and waits until it gets there
+func setInstancePowerState(ctx context.Context, apiClient *civogo.Client, id, desiredState string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(id)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	var err error
+	switch desiredState {
+	case desiredStateStopped:
+		tflog.Info(ctx, fmt.Sprintf("stopping the instance %s", id))
+		_, err = apiClient.StopInstance(id)
+		stateConf.Pending = []string{"ACTIVE", "STOPPING", "SHUTTING_DOWN", "REBOOTING"}
+		stateConf.Target = []string{"SHUTOFF"}
+	case desiredStateRunning:
+		tflog.Info(ctx, fmt.Sprintf("starting the instance %s", id))
+		_, err = apiClient.StartInstance(id)
+		stateConf.Pending = []string{"SHUTOFF", "STARTING", "BUILDING", "REBOOTING"}
+		stateConf.Target = []string{"ACTIVE"}
+	default:
+		return fmt.Errorf("[ERR] unknown desired state %q", desiredState)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERR] an error occurred while changing the instance %s to %s: %s", id, desiredState, err)
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to be %s: %s", id, desiredState, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resizeCustomizeDiff plans a change of `size` as an in-place resize, unless the new size has a smaller
+// disk than the current one. Civo can't shrink the disk of an instance, so the instance is replaced instead.
+func resizeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("size") || !d.NewValueKnown("size") {
+		return nil
+	}
+
+	meta, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	sizes, err := utils.Cached(meta, "sizes/"+apiClient.Region, apiClient.ListInstanceSizes)
+	if err != nil {
+		// the resize endpoint rejects the change if it isn't allowed, so it's fine to plan it in place
+		tflog.Warn(ctx, "unable to list the sizes to check if the instance can be resized in place", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	oldSize, newSize := d.GetChange("size")
+	disks := map[string]int{}
+	for _, size := range sizes {
+		disks[size.Name] = size.DiskGigabytes
+	}
+
+	oldDisk, oldFound := disks[oldSize.(string)]
+	newDisk, newFound := disks[newSize.(string)]
+	if oldFound && newFound && newDisk < oldDisk {
+		return d.ForceNew("size")
+	}
+
+	return nil
+}
@@ -2,13 +2,15 @@ package instances
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -36,14 +38,15 @@ func ResourceInstance() *schema.Resource {
 			"reverse_dns": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "A fully qualified domain name that should be used as the instance's IP's reverse DNS (optional, uses the hostname if unspecified)",
+				Computed:     true,
+				Description:  "A fully qualified domain name that should be used as the instance's IP's reverse DNS (optional, uses the hostname if unspecified). It's updated in place",
 				ValidateFunc: utils.ValidateName,
 			},
 			"size": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "g3.xsmall",
-				Description: "The name of the size, from the current list, e.g. g3.xsmall",
+				Description: "The name of the size, from the current list, e.g. g3.xsmall. It must be available in the region. GPU sizes take longer to provision, raise the create timeout with `timeouts { create = ... }` for them. The instance is resized in place, unless the new size has a smaller disk in which case a new instance is created",
 			},
 			"public_ip_required": {
 				Type:        schema.TypeString,
@@ -71,7 +74,7 @@ func ResourceInstance() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ExactlyOneOf: []string{"template", "disk_image"},
-				Description:  "The ID for the disk image to use to build the instance",
+				Description:  "The ID or the name of the disk image to use to build the instance, it's checked against the disk images of the region when planning",
 				ForceNew:     true,
 			},
 			"initial_user": {
@@ -83,7 +86,7 @@ func ResourceInstance() *schema.Resource {
 			"notes": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Add some notes to the instance",
+				Description: "Add some notes to the instance, they are updated in place",
 			},
 			"sshkey_id": {
 				Type:        schema.TypeString,
@@ -95,7 +98,7 @@ func ResourceInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The ID of the firewall to use, from the current list. If left blank or not sent, the default firewall will be used (open to all)",
+				Description: "The ID of the firewall to use, from the current list. If left blank or not sent, the default firewall will be used (open to all). Changing it moves the instance to the new firewall without recreating it",
 			},
 			"tags": {
 				Type:        schema.TypeSet,
@@ -103,12 +106,59 @@ func ResourceInstance() *schema.Resource {
 				Description: "An optional list of tags, represented as a key, value pair",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"desired_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      desiredStateRunning,
+				ValidateFunc: validation.StringInSlice([]string{desiredStateRunning, desiredStateStopped}, false),
+				Description:  "The power state of the instance, either `running` or `stopped` (default: `running`). The instance is started or shut down when it changes",
+			},
+			"volume":              instanceVolumeSchema(),
+			"tags_all":            utils.TagsAllSchema(),
+			"deletion_protection": utils.DeletionProtectionSchema(),
 			"script": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The contents of a script that will be uploaded to /usr/local/bin/civo-user-init-script on your instance, " +
-					"read/write/executable only by root and then will be executed at the end of the cloud initialization",
-				ValidateFunc: validation.StringIsNotEmpty,
+					"read/write/executable only by root and then will be executed at the end of the cloud initialization. " +
+					"See `reprovision_on_script_change` for what happens when it changes",
+				ValidateFunc:     validation.StringIsNotEmpty,
+				ConflictsWith:    []string{"user_data", "user_data_base64"},
+				DiffSuppressFunc: suppressScriptDiff,
+			},
+			"reprovision_on_script_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, changing the `script` of an existing instance creates a new instance so the new script runs, " +
+					"otherwise changes to the script are ignored as it only runs when the instance is created (default: false)",
+			},
+			"prevent_kubernetes_node_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, resizing, replacing or deleting the instance fails when it's a node of a Kubernetes cluster, " +
+					"as the provider can't cordon and drain the node first. Resize its node pool or recycle it with `civo_kubernetes_node_recycle` instead (default: false)",
+			},
+			"user_data": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				StateFunc:     userDataStateFunc,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"script", "user_data_base64"},
+				Description: "A cloud-init script passed to the instance when it is created, only its hash is stored in the state. " +
+					"Changing it creates a new instance",
+			},
+			"user_data_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				StateFunc:     userDataBase64StateFunc,
+				ValidateFunc:  validateUserDataBase64,
+				ConflictsWith: []string{"script", "user_data"},
+				Description: "Same as `user_data` but base64 encoded, for example with `base64encode()`. The decoded content has to be UTF-8 text, binary content like a gzip compressed cloud-init config isn't supported. " +
+					"Only the hash of the decoded content is stored in the state. Changing it creates a new instance",
 			},
 			// Computed resource
 			"cpu_cores": {
@@ -121,6 +171,7 @@ func ResourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Instance's RAM (MB)",
 			},
+			"gpu_count": utils.GPUCountSchema(),
 			"disk_gb": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -136,11 +187,22 @@ func ResourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Instance's source ID",
 			},
+			"volume_backed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the root disk of the instance is a volume",
+			},
 			"initial_password": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "Initial password for login",
+				Description: "Initial password for login, generated by Civo when no `sshkey_id` is set. It's empty when `store_initial_password` is false",
+			},
+			"store_initial_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If false, the initial password generated by Civo is not stored in the state (default: true)",
 			},
 			"private_ip": {
 				Type:        schema.TypeString,
@@ -162,6 +224,18 @@ func ResourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Timestamp when the instance was created",
 			},
+			"enable_ipv6": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If true, the instance must be created in a network with IPv6 enabled so it gets a public IPv6 address, the creation fails if the network or the region don't support IPv6",
+			},
+			"public_ipv6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Instance's public IPv6 address, empty if the network of the instance doesn't have IPv6 enabled",
+			},
 			"private_ipv4": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -177,25 +251,32 @@ func ResourceInstance() *schema.Resource {
 		ReadContext:   resourceInstanceRead,
 		UpdateContext: resourceInstanceUpdate,
 		DeleteContext: resourceInstanceDelete,
+		CustomizeDiff: customdiff.All(
+			utils.TagsAllCustomizeDiff(func(d *schema.ResourceDiff) []string {
+				return utils.SetToStrings(d.Get("tags").(*schema.Set))
+			}),
+			resizeCustomizeDiff,
+			utils.SizeCustomizeDiff,
+			scriptCustomizeDiff,
+			diskImageCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceInstanceImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 	}
 }
 
 // function to create an instance
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] configuring the instance %s", d.Get("hostname").(string))
+	tflog.Info(ctx, fmt.Sprintf("configuring the instance %s", d.Get("hostname").(string)))
 	config := &civogo.InstanceConfig{
 		Count:            1,
 		Hostname:         utils.RandomName(),
@@ -241,6 +322,17 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		config.NetworkID = defaultNetwork.ID
 	}
 
+	// instances get an IPv6 address from their network, so the network must have IPv6 enabled
+	if d.Get("enable_ipv6").(bool) {
+		network, err := apiClient.GetNetwork(config.NetworkID)
+		if err != nil {
+			return diag.Errorf("[ERR] failed to get the network %s: %s", config.NetworkID, err)
+		}
+		if !network.IPv6Enabled {
+			return diag.Errorf("[ERR] enable_ipv6 is set but the network %s doesn't have IPv6 enabled, IPv6 may not be available in the region %s", network.Label, apiClient.Region)
+		}
+	}
+
 	if attr, ok := d.GetOk("template"); ok {
 		findTemplate, err := apiClient.FindDiskImage(attr.(string))
 		if err != nil {
@@ -265,20 +357,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		config.SSHKeyID = attr.(string)
 	}
 
-	if attr, ok := d.GetOk("script"); ok {
-		config.Script = attr.(string)
-	}
-
-	tfTags := d.Get("tags").(*schema.Set).List()
-	tags := make([]string, len(tfTags))
-	for i, tfTag := range tfTags {
-		tags[i] = tfTag.(string)
+	script, err := instanceScript(d.Get("user_data").(string), d.Get("user_data_base64").(string), d.Get("script").(string))
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	config.Script = script
 
-	config.Tags = tags
+	config.Tags = utils.MergeDefaultTags(m, utils.SetToStrings(d.Get("tags").(*schema.Set)))
 
-	log.Printf("[INFO] creating the instance %s", d.Get("hostname").(string))
-	err := utils.RetryUntilSuccessOrTimeout(func() error {
+	tflog.Info(ctx, fmt.Sprintf("creating the instance %s", d.Get("hostname").(string)))
+	err = utils.RetryUntilSuccessOrTimeout(ctx, func() error {
 		instance, err := apiClient.CreateInstance(config)
 		if err != nil {
 			return err
@@ -301,7 +389,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 60,
@@ -312,9 +400,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	if attr, ok := d.GetOk("firewall_id"); ok {
-		_, errInstance := apiClient.SetInstanceFirewall(d.Id(), attr.(string))
-		if errInstance != nil {
-			return diag.Errorf("[ERR] updating instance firewall: %s", err)
+		if err := setInstanceFirewall(ctx, apiClient, d.Id(), attr.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -326,7 +413,19 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		resp.Notes = attr.(string)
 		_, errInstance := apiClient.UpdateInstance(resp)
 		if errInstance != nil {
-			return diag.Errorf("[ERR] updating instance notes: %s", err)
+			return diag.Errorf("[ERR] updating instance notes: %s", errInstance)
+		}
+	}
+
+	for id := range volumeIDs(d.Get("volume").(*schema.Set)) {
+		if err := attachInstanceVolume(ctx, apiClient, d.Id(), id, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("desired_state").(string) == desiredStateStopped {
+		if err := setInstancePowerState(ctx, apiClient, d.Id(), desiredStateStopped, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -335,20 +434,14 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 // function to read the instance
-func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] retriving the instance %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the instance %s", d.Id()))
 	resp, err := apiClient.GetInstance(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "instance")
 		}
 
 		return diag.Errorf("[ERR] failed to retriving the instance: %s", err)
@@ -357,21 +450,53 @@ func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface
 	d.Set("hostname", resp.Hostname)
 	d.Set("reverse_dns", resp.ReverseDNS)
 	d.Set("size", resp.Size)
+	if state := instanceDesiredState(resp.Status); state != "" {
+		d.Set("desired_state", state)
+	}
 	d.Set("cpu_cores", resp.CPUCores)
+	if size, err := utils.FindSize(m, apiClient, resp.Size); err == nil && size != nil {
+		d.Set("gpu_count", size.GPUCount)
+	}
 	d.Set("ram_mb", resp.RAMMegabytes)
 	d.Set("disk_gb", resp.DiskGigabytes)
 	d.Set("initial_user", resp.InitialUser)
-	d.Set("initial_password", resp.InitialPassword)
+	if d.Get("store_initial_password").(bool) {
+		d.Set("initial_password", resp.InitialPassword)
+	} else {
+		d.Set("initial_password", "")
+	}
 	d.Set("source_type", resp.SourceType)
 	d.Set("source_id", resp.SourceID)
+	d.Set("volume_backed", resp.VolumeBacked)
 	d.Set("sshkey_id", resp.SSHKeyID)
-	d.Set("tags", resp.Tags)
+	d.Set("tags", utils.RemoveDefaultTags(m, resp.Tags, utils.SetToStrings(d.Get("tags").(*schema.Set))))
+	d.Set("tags_all", resp.Tags)
 	d.Set("private_ip", resp.PrivateIP)
 	d.Set("public_ip", resp.PublicIP)
+	d.Set("public_ipv6", resp.IPv6)
 	d.Set("network_id", resp.NetworkID)
 	d.Set("firewall_id", resp.FirewallID)
+
+	volumes, err := attachedInstanceVolumes(apiClient, d.Id(), d.Get("volume").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("volume", volumes)
 	d.Set("status", resp.Status)
-	d.Set("script", resp.Script)
+	// the script is stored as a hash when it comes from user_data or user_data_base64, it only
+	// changes in the state when the script returned by the API is a different one
+	switch {
+	case d.Get("user_data").(string) != "":
+		if resp.Script != "" && !userDataMatches(d.Get("user_data").(string), resp.Script) {
+			d.Set("user_data", userDataHashSum(resp.Script))
+		}
+	case d.Get("user_data_base64").(string) != "":
+		if resp.Script != "" && !userDataMatches(d.Get("user_data_base64").(string), resp.Script) {
+			d.Set("user_data_base64", userDataHashSum(resp.Script))
+		}
+	default:
+		d.Set("script", resp.Script)
+	}
 	d.Set("reserved_ipv4", resp.ReservedIP)
 	d.Set("created_at", resp.CreatedAt.UTC().String())
 	d.Set("notes", resp.Notes)
@@ -392,21 +517,22 @@ func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface
 
 // function to update an instance
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	// check if the size change if change we send to resize the instance
+	// check if the size change if change we send to resize the instance, a resize to a smaller disk is planned as a replacement
 	if d.HasChange("size") {
 		newSize := d.Get("size").(string)
 
-		log.Printf("[INFO] resizing the instance %s", d.Id())
+		if d.Get("prevent_kubernetes_node_changes").(bool) {
+			if err := checkNotKubernetesNode(ctx, apiClient, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("resizing the instance %s", d.Id()))
 		_, err := apiClient.UpgradeInstance(d.Id(), newSize)
 		if err != nil {
-			return diag.Errorf("[WARN] An error occurred while resizing the instance %s", d.Id())
+			return diag.Errorf("[ERR] an error occurred while resizing the instance %s: %s", d.Id(), err)
 		}
 
 		createStateConf := &resource.StateChangeConf{
@@ -419,19 +545,25 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 				}
 				return resp, resp.Status, nil
 			},
-			Timeout:        60 * time.Minute,
+			Timeout:        d.Timeout(schema.TimeoutUpdate),
 			Delay:          3 * time.Second,
 			MinTimeout:     3 * time.Second,
 			NotFoundChecks: 60,
 		}
 		_, err = createStateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return diag.Errorf("error waiting for instance (%s) to be created: %s", d.Id(), err)
+			return diag.Errorf("error waiting for instance (%s) to be resized: %s", d.Id(), err)
 		}
 	}
 
-	// if notes or hostname have changed, add them to the instance
-	if d.HasChange("notes") || d.HasChange("hostname") {
+	if d.HasChange("desired_state") {
+		if err := setInstancePowerState(ctx, apiClient, d.Id(), d.Get("desired_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// if notes, hostname or reverse dns have changed, add them to the instance
+	if d.HasChanges("notes", "hostname", "reverse_dns") {
 		notes := d.Get("notes").(string)
 		hostname := d.Get("hostname").(string)
 
@@ -447,23 +579,28 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		if d.HasChange("hostname") {
 			instance.Hostname = hostname
 		}
+		if d.HasChange("reverse_dns") {
+			instance.ReverseDNS = d.Get("reverse_dns").(string)
+		}
 
-		log.Printf("[INFO] updating instance %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("updating instance %s", d.Id()))
 		_, err = apiClient.UpdateInstance(instance)
 		if err != nil {
-			return diag.Errorf("[ERR] an error occurred while updating notes or hostname of the instance %s", d.Id())
+			return diag.Errorf("[ERR] an error occurred while updating notes, hostname or reverse dns of the instance %s: %s", d.Id(), err)
 		}
 	}
 
 	// if a firewall is declared we update the instance
 	if d.HasChange("firewall_id") {
-		firewallID := d.Get("firewall_id").(string)
+		if err := setInstanceFirewall(ctx, apiClient, d.Id(), d.Get("firewall_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-		log.Printf("[INFO] adding firewall to the instance %s", d.Id())
-		_, err := apiClient.SetInstanceFirewall(d.Id(), firewallID)
-		if err != nil {
-			// check if the instance no longer exists.
-			return diag.Errorf("[ERR] an error occurred while set firewall to the instance %s", d.Id())
+	if d.HasChange("volume") {
+		oldVolumes, newVolumes := d.GetChange("volume")
+		if err := updateInstanceVolumes(ctx, apiClient, d.Id(), oldVolumes.(*schema.Set), newVolumes.(*schema.Set), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -475,13 +612,9 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("[ERR] updating sshkey_id is not supported")
 	}
 
-	// if tags is declare we update the instance with the tags
-	if d.HasChange("tags") {
-		tfTags := d.Get("tags").(*schema.Set).List()
-		tags := make([]string, len(tfTags))
-		for i, tfTag := range tfTags {
-			tags[i] = tfTag.(string)
-		}
+	// if tags is declare we update the instance with the tags, the default tags of the provider included
+	if d.HasChanges("tags", "tags_all") {
+		tags := utils.MergeDefaultTags(m, utils.SetToStrings(d.Get("tags").(*schema.Set)))
 
 		instance, err := apiClient.GetInstance(d.Id())
 		if err != nil {
@@ -491,7 +624,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 		tagsToString := strings.Join(tags, " ")
 
-		log.Printf("[INFO] adding tags to the instance %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("adding tags to the instance %s", d.Id()))
 		_, err = apiClient.SetInstanceTags(instance, tagsToString)
 		if err != nil {
 			return diag.Errorf("[ERR] an error occurred while adding tags to the instance %s", d.Id())
@@ -502,18 +635,52 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	return resourceInstanceRead(ctx, d, m)
 }
 
+// function to import an instance, the arguments only kept in the state are set to their defaults so the import doesn't plan a change
+func resourceInstanceImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	apiClient := utils.ResourceClient(m, d)
+
+	instance, err := apiClient.GetInstance(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to retrieve the instance %s: %s", d.Id(), err)
+	}
+
+	d.Set("store_initial_password", true)
+	d.Set("reprovision_on_script_change", false)
+	d.Set("prevent_kubernetes_node_changes", false)
+	d.Set("deletion_protection", false)
+	// changing enable_ipv6 replaces the instance, so it's taken from the IPv6 address of the instance
+	d.Set("enable_ipv6", instance.IPv6 != "")
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // function to delete instance
-func resourceInstanceDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := utils.CheckDeletionProtection(d, "instance"); diags != nil {
+		return diags
+	}
+
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	if d.Get("prevent_kubernetes_node_changes").(bool) {
+		if err := checkNotKubernetesNode(ctx, apiClient, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	log.Printf("[INFO] deleting the instance %s", d.Id())
+	// detach the volumes of the instance first, so they are left available to be attached somewhere else
+	for id := range volumeIDs(d.Get("volume").(*schema.Set)) {
+		if err := detachInstanceVolume(ctx, apiClient, id, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deleting the instance %s", d.Id()))
 	_, err := apiClient.DeleteInstance(d.Id())
 	if err != nil {
+		if utils.IsNotFound(err) {
+			return nil
+		}
 		return diag.Errorf("[ERR] an error occurred while trying to delete instance %s", d.Id())
 	}
 	return nil
@@ -2,10 +2,13 @@ package instances
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,8 +29,7 @@ func ResourceInstanceReservedIPAssignment() *schema.Resource {
 			"instance_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "The instance id",
+				Description: "The instance id, changing it moves the reserved ip to the new instance without recreating the assignment",
 			},
 			"region": {
 				Type:        schema.TypeString,
@@ -38,21 +40,22 @@ func ResourceInstanceReservedIPAssignment() *schema.Resource {
 		},
 		CreateContext: resourceInstanceReservedIPCreate,
 		ReadContext:   resourceInstanceReservedIPRead,
+		UpdateContext: resourceInstanceReservedIPUpdate,
 		DeleteContext: resourceInstanceReservedIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstanceReservedIPImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 	}
 }
 
 // function to create a instance
 func resourceInstanceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	// We check if the instance is valid and if it is not we return an error
 	instance, err := apiClient.GetInstance(d.Get("instance_id").(string))
@@ -71,50 +74,19 @@ func resourceInstanceReservedIPCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// We send to assign the reserved ip to the instance
-	log.Printf("[INFO] assigning the reserved ip %s to the instance %s", d.Get("reserved_ip_id").(string), d.Get("instance_id").(string))
-
-	_, err = apiClient.AssignIP(reservedIP.ID, instance.ID, "instance", apiClient.Region)
-	if err != nil {
-		return diag.Errorf("[ERR] an error occurred while trying to assign reserved ip %s to instance %s", d.Get("reserved_ip_id").(string), d.Get("instance_id").(string))
+	if err := assignReservedIP(ctx, apiClient, reservedIP, instance.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resource.UniqueId())
 
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING"},
-		Target:  []string{"ASSIGNED"},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := apiClient.GetInstance(instance.ID)
-			if err != nil {
-				return 0, "", err
-			}
-			if resp.PublicIP != reservedIP.IP {
-				return 0, "PENDING", nil
-			}
-			return resp, "ASSIGNED", nil
-		},
-		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 60,
-	}
-	_, err = createStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.Errorf("error waiting for ip be assingne (%s) to the instance: %s", d.Id(), err)
-	}
-
 	return resourceInstanceReservedIPRead(ctx, d, m)
 
 }
 
 // function to read the instance
-func resourceInstanceReservedIPRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceInstanceReservedIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	instanceID := d.Get("instance_id").(string)
 	reservedID := d.Get("reserved_ip_id").(string)
@@ -122,33 +94,125 @@ func resourceInstanceReservedIPRead(_ context.Context, d *schema.ResourceData, m
 	// We check if the reserved ip is valid and if it is not we return an error
 	reservedIP, err := apiClient.FindIP(reservedID)
 	if err != nil {
-		return diag.Errorf("[ERR] an error occurred while trying to get reserved ip %s", reservedID)
+		if errors.Is(err, civogo.ZeroMatchesError) {
+			tflog.Warn(ctx, fmt.Sprintf("the reserved ip %s no longer exists, removing the assignment from the state", reservedID))
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERR] an error occurred while trying to get reserved ip %s: %s", reservedID, err)
 	}
 
 	if reservedIP.AssignedTo.ID != instanceID {
+		tflog.Warn(ctx, fmt.Sprintf("the reserved ip %s is not assigned to the instance %s anymore, removing the assignment from the state", reservedIP.ID, instanceID))
 		d.SetId("")
-		return diag.Errorf("[ERR] the reserved ip %s is not assigned to the instance %s", reservedIP.ID, instanceID)
+		return nil
 	}
 
 	return nil
 }
 
+// function to move the reserved ip to another instance
+func resourceInstanceReservedIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	if d.HasChange("instance_id") {
+		oldInstanceID, newInstanceID := d.GetChange("instance_id")
+
+		instance, err := apiClient.GetInstance(newInstanceID.(string))
+		if err != nil {
+			return diag.Errorf("[ERR] an error occurred while trying to get instance %s: %s", newInstanceID.(string), err)
+		}
+
+		reservedIP, err := apiClient.FindIP(d.Get("reserved_ip_id").(string))
+		if err != nil {
+			return diag.Errorf("[ERR] an error occurred while trying to get reserved ip %s: %s", d.Get("reserved_ip_id").(string), err)
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("moving the reserved ip %s from the instance %s to the instance %s", reservedIP.ID, oldInstanceID.(string), instance.ID))
+		if err := unassignReservedIP(ctx, apiClient, reservedIP.ID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := assignReservedIP(ctx, apiClient, reservedIP, instance.ID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceInstanceReservedIPRead(ctx, d, m)
+}
+
 // function to delete instance
 func resourceInstanceReservedIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	if err := unassignReservedIP(ctx, apiClient, d.Get("reserved_ip_id").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	reservedIP := d.Get("reserved_ip_id").(string)
+	return nil
+}
 
-	// We check if the reserved ip is valid and if it is not we return an error
-	log.Printf("[INFO] unassign the ip (%s) from the instance", reservedIP)
+// function to import the assignment of a reserved ip, the ID is the ID, name or address of the reserved ip
+func resourceInstanceReservedIPImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	apiClient := utils.ResourceClient(m, d)
+
+	reservedIP, err := apiClient.FindIP(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] an error occurred while trying to get reserved ip %s: %s", d.Id(), err)
+	}
+
+	if reservedIP.AssignedTo.ID == "" {
+		return nil, fmt.Errorf("[ERR] the reserved ip %s is not assigned to any instance", reservedIP.ID)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("reserved_ip_id", reservedIP.ID)
+	d.Set("instance_id", reservedIP.AssignedTo.ID)
+	d.Set("region", apiClient.Region)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// assignReservedIP assigns the reserved ip to the instance and waits until the instance has it as public ip
+func assignReservedIP(ctx context.Context, apiClient *civogo.Client, reservedIP *civogo.IP, instanceID string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("assigning the reserved ip %s to the instance %s", reservedIP.ID, instanceID))
+
+	_, err := apiClient.AssignIP(reservedIP.ID, instanceID, "instance", apiClient.Region)
+	if err != nil {
+		return fmt.Errorf("[ERR] an error occurred while trying to assign reserved ip %s to instance %s: %s", reservedIP.ID, instanceID, err)
+	}
+
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"ASSIGNED"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(instanceID)
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.PublicIP != reservedIP.IP {
+				return 0, "PENDING", nil
+			}
+			return resp, "ASSIGNED", nil
+		},
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     3 * time.Second,
+		NotFoundChecks: 60,
+	}
+	_, err = createStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for ip (%s) to be assigned to the instance %s: %s", reservedIP.ID, instanceID, err)
+	}
+
+	return nil
+}
+
+// unassignReservedIP unassigns the reserved ip from its instance and waits until it is free
+func unassignReservedIP(ctx context.Context, apiClient *civogo.Client, reservedIP string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("unassign the ip (%s) from the instance", reservedIP))
 	_, err := apiClient.UnassignIP(reservedIP, apiClient.Region)
 	if err != nil {
-		return diag.Errorf("[ERR] an error occurred while trying to unassign the ip %s: %s", reservedIP, err)
+		return fmt.Errorf("[ERR] an error occurred while trying to unassign the ip %s: %s", reservedIP, err)
 	}
 
 	createStateConf := &resource.StateChangeConf{
@@ -164,14 +228,14 @@ func resourceInstanceReservedIPDelete(ctx context.Context, d *schema.ResourceDat
 			}
 			return resp, "DONE", nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        timeout,
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.Errorf("error waiting for ip be unassign (%s): %s", d.Id(), err)
+		return fmt.Errorf("error waiting for ip be unassign (%s): %s", reservedIP, err)
 	}
 
 	return nil
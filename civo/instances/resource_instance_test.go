@@ -2,6 +2,7 @@ package instances_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/civo/civogo"
@@ -46,6 +47,12 @@ func TestAccCivoInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "created_at"),
 				),
 			},
+			{
+				// the arguments only kept in the state are set to their defaults on import
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -145,6 +152,7 @@ func TestAccCivoInstanceNotes_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "ram_mb", "2048"),
 					resource.TestCheckResourceAttr(resName, "disk_gb", "25"),
 					resource.TestCheckResourceAttr(resName, "notes", "the_test_notes"),
+					resource.TestCheckResourceAttr(resName, "reverse_dns", "mail."+instanceHostname),
 					resource.TestCheckResourceAttrSet(resName, "initial_password"),
 					resource.TestCheckResourceAttrSet(resName, "private_ip"),
 					resource.TestCheckResourceAttrSet(resName, "public_ip"),
@@ -207,6 +215,92 @@ func TestAccCivoInstanceFirewall_update(t *testing.T) {
 	})
 }
 
+// TestAccCivoInstance_userData is a test function that verifies an instance can be created with cloud-init user data.
+func TestAccCivoInstance_userData(t *testing.T) {
+	var instance civogo.Instance
+
+	// generate a random name for each test run
+	resName := "civo_instance.foobar"
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// use a dynamic configuration with the random name from above
+				Config: CivoInstanceConfigUserData(instanceHostname),
+				// compose a basic test, checking both remote and local values
+				Check: resource.ComposeTestCheckFunc(
+					// query the API to retrieve the widget object
+					acceptance.CivoInstanceResourceExists(resName, &instance),
+					// verify remote values
+					CivoInstanceValues(&instance, instanceHostname),
+					// verify local values
+					resource.TestCheckResourceAttr(resName, "hostname", instanceHostname),
+					resource.TestCheckResourceAttrSet(resName, "user_data"),
+				),
+			},
+			{
+				// the same user data must not show up as a diff
+				Config:   CivoInstanceConfigUserData(instanceHostname),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccCivoInstance_userDataBase64Binary is a test function that verifies binary user data is refused when planning.
+func TestAccCivoInstance_userDataBase64Binary(t *testing.T) {
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      CivoInstanceConfigUserDataBase64(instanceHostname, `base64gzip("#cloud-config")`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("not valid UTF-8 text"),
+			},
+		},
+	})
+}
+
+// TestAccCivoInstance_desiredState is a test function that verifies an instance can be stopped and started again.
+func TestAccCivoInstance_desiredState(t *testing.T) {
+	var instance civogo.Instance
+
+	// generate a random name for each test run
+	resName := "civo_instance.foobar"
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoInstanceConfigDesiredState(instanceHostname, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "desired_state", "running"),
+					resource.TestCheckResourceAttr(resName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: CivoInstanceConfigDesiredState(instanceHostname, "stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "desired_state", "stopped"),
+					resource.TestCheckResourceAttr(resName, "status", "SHUTOFF"),
+				),
+			},
+		},
+	})
+}
+
 func CivoInstanceValues(instance *civogo.Instance, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if instance.Hostname != name {
@@ -315,7 +409,8 @@ resource "civo_instance" "foobar" {
 	size = element(data.civo_instances_size.small.sizes, 0).name
 	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
 	notes = "the_test_notes"
-}`, hostname)
+	reverse_dns = "mail.%s"
+}`, hostname, hostname)
 }
 
 func CivoInstanceConfigFirewall(hostname string) string {
@@ -353,3 +448,78 @@ resource "civo_instance" "foobar" {
 	firewall_id = civo_firewall.foobar.id
 }`, hostname)
 }
+
+func CivoInstanceConfigUserData(hostname string) string {
+	return fmt.Sprintf(`
+data "civo_size" "small" {
+	filter {
+		key = "name"
+		values = ["g3.small"]
+		match_by = "re"
+	}
+
+	filter {
+		key = "type"
+		values = ["instance"]
+	}
+}
+
+# Query instance disk image
+data "civo_disk_image" "debian" {
+	filter {
+		key = "name"
+		values = ["debian-10"]
+	}
+}
+
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = element(data.civo_size.small.sizes, 0).name
+	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+	user_data = <<-EOT
+		#!/bin/bash
+		echo "hello from cloud-init" > /root/hello
+	EOT
+}`, hostname)
+}
+
+func CivoInstanceConfigUserDataBase64(hostname, userData string) string {
+	return fmt.Sprintf(`
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = "g3.small"
+	disk_image = "debian-10"
+	user_data_base64 = %s
+}`, hostname, userData)
+}
+
+func CivoInstanceConfigDesiredState(hostname, desiredState string) string {
+	return fmt.Sprintf(`
+data "civo_size" "small" {
+	filter {
+		key = "name"
+		values = ["g3.small"]
+		match_by = "re"
+	}
+
+	filter {
+		key = "type"
+		values = ["instance"]
+	}
+}
+
+# Query instance disk image
+data "civo_disk_image" "debian" {
+	filter {
+		key = "name"
+		values = ["debian-10"]
+	}
+}
+
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = element(data.civo_size.small.sizes, 0).name
+	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+	desired_state = "%s"
+}`, hostname, desiredState)
+}
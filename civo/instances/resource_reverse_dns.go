@@ -0,0 +1,190 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceReverseDNS The reverse DNS resource, with this we can manage the PTR record of the IP of an instance
+// apart from the instance
+func ResourceReverseDNS() *schema.Resource {
+	return &schema.Resource{
+		Description: strings.Join([]string{
+			"Provides a Civo reverse DNS resource, managing the PTR record of the public IP of an instance apart from the `civo_instance` resource. The IP can be the reserved IP assigned to the instance.",
+			"The `reverse_dns` of the instance shouldn't be declared in the `civo_instance` resource too. When the resource is deleted, the reverse DNS of the instance is set back to its hostname.",
+		}, "\n\n"),
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "ip"},
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the instance",
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "ip"},
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "The public IP of the instance or the reserved IP assigned to it, the instance is looked up from the IP",
+			},
+			"reverse_dns": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: utils.ValidateName,
+				Description:  "A fully qualified domain name that should be used as the reverse DNS of the IP. It's updated in place",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the instance, if is not defined we use the global defined in the provider",
+			},
+		},
+		CreateContext: resourceReverseDNSCreate,
+		ReadContext:   resourceReverseDNSRead,
+		UpdateContext: resourceReverseDNSUpdate,
+		DeleteContext: resourceReverseDNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// findInstanceByIP returns the instance with the IP, the reserved IPs are looked up first as they're the
+// IPs kept when the instances are replaced
+func findInstanceByIP(apiClient *civogo.Client, ip string) (*civogo.Instance, error) {
+	reservedIPs, err := apiClient.ListIPs()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the reserved ips: %s", err)
+	}
+	for _, reservedIP := range reservedIPs.Items {
+		if reservedIP.IP != ip {
+			continue
+		}
+		if reservedIP.AssignedTo.ID == "" {
+			return nil, fmt.Errorf("[ERR] the reserved ip %s is not assigned to an instance", ip)
+		}
+		return apiClient.GetInstance(reservedIP.AssignedTo.ID)
+	}
+
+	instances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the instances: %s", err)
+	}
+	for _, instance := range instances {
+		if instance.PublicIP == ip {
+			return &instance, nil
+		}
+	}
+
+	return nil, fmt.Errorf("[ERR] no instance has the ip %s", ip)
+}
+
+// setInstanceReverseDNS sets the reverse DNS of the IP of the instance, keeping the rest of the instance
+func setInstanceReverseDNS(ctx context.Context, apiClient *civogo.Client, instanceID, reverseDNS string) error {
+	instance, err := apiClient.GetInstance(instanceID)
+	if err != nil {
+		return fmt.Errorf("[ERR] instance %s not found: %s", instanceID, err)
+	}
+
+	instance.ReverseDNS = reverseDNS
+
+	tflog.Info(ctx, fmt.Sprintf("setting the reverse dns of the instance %s to %s", instanceID, reverseDNS))
+	if _, err := apiClient.UpdateInstance(instance); err != nil {
+		return fmt.Errorf("[ERR] an error occurred while updating the reverse dns of the instance %s: %s", instanceID, err)
+	}
+
+	return nil
+}
+
+// function to set the reverse dns of an instance
+func resourceReverseDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	instanceID := d.Get("instance_id").(string)
+	if ip, ok := d.GetOk("ip"); ok {
+		instance, err := findInstanceByIP(apiClient, ip.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		instanceID = instance.ID
+	}
+
+	if err := setInstanceReverseDNS(ctx, apiClient, instanceID, d.Get("reverse_dns").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceReverseDNSRead(ctx, d, m)
+}
+
+// function to read the reverse dns of an instance
+func resourceReverseDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	tflog.Info(ctx, fmt.Sprintf("retrieving the reverse dns of the instance %s", d.Id()))
+	instance, err := apiClient.GetInstance(d.Id())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "reverse dns")
+		}
+		return diag.Errorf("[ERR] failed to retrieve instance: %s", err)
+	}
+
+	d.Set("instance_id", instance.ID)
+	d.Set("reverse_dns", instance.ReverseDNS)
+	d.Set("region", apiClient.Region)
+	// the declared ip is kept, it can be the reserved ip assigned to the instance
+	if _, ok := d.GetOk("ip"); !ok {
+		d.Set("ip", instance.PublicIP)
+	}
+
+	return nil
+}
+
+// function to update the reverse dns of an instance
+func resourceReverseDNSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	if d.HasChange("reverse_dns") {
+		if err := setInstanceReverseDNS(ctx, apiClient, d.Id(), d.Get("reverse_dns").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceReverseDNSRead(ctx, d, m)
+}
+
+// function to set the reverse dns of an instance back to its hostname
+func resourceReverseDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	instance, err := apiClient.GetInstance(d.Id())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("[ERR] failed to retrieve instance: %s", err)
+	}
+
+	if err := setInstanceReverseDNS(ctx, apiClient, instance.ID, instance.Hostname); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
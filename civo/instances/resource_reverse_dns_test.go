@@ -0,0 +1,94 @@
+package instances_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCivoReverseDNS_basic(t *testing.T) {
+	var instance civogo.Instance
+
+	// generate a random name for each test run
+	resName := "civo_reverse_dns.foobar"
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoReverseDNSConfig(instanceHostname, "mail"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists("civo_instance.foobar", &instance),
+					CivoReverseDNSValue(&instance, "mail."+instanceHostname),
+					resource.TestCheckResourceAttrPair(resName, "instance_id", "civo_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "reverse_dns", "mail."+instanceHostname),
+				),
+			},
+			{
+				// the reverse dns is updated in place
+				Config: CivoReverseDNSConfig(instanceHostname, "smtp"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists("civo_instance.foobar", &instance),
+					CivoReverseDNSValue(&instance, "smtp."+instanceHostname),
+					resource.TestCheckResourceAttr(resName, "reverse_dns", "smtp."+instanceHostname),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func CivoReverseDNSValue(instance *civogo.Instance, reverseDNS string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if instance.ReverseDNS != reverseDNS {
+			return fmt.Errorf("bad reverse dns, expected \"%s\", got: %#v", reverseDNS, instance.ReverseDNS)
+		}
+		return nil
+	}
+}
+
+func CivoReverseDNSConfig(hostname, prefix string) string {
+	return fmt.Sprintf(`
+data "civo_size" "small" {
+	filter {
+		key = "name"
+		values = ["g3.small"]
+		match_by = "re"
+	}
+
+	filter {
+		key = "type"
+		values = ["instance"]
+	}
+}
+
+# Query instance disk image
+data "civo_disk_image" "debian" {
+	filter {
+		key = "name"
+		values = ["debian-10"]
+	}
+}
+
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = element(data.civo_size.small.sizes, 0).name
+	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+}
+
+resource "civo_reverse_dns" "foobar" {
+	ip = civo_instance.foobar.public_ip
+	reverse_dns = "%s.%s"
+}`, hostname, prefix, hostname)
+}
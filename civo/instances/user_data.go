@@ -0,0 +1,107 @@
+package instances
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeUserData removes the differences the Civo API introduces when it stores the user data,
+// the line endings are converted to `\n` and the trailing whitespace is dropped
+func normalizeUserData(userData string) string {
+	return strings.TrimRight(strings.ReplaceAll(userData, "\r\n", "\n"), " \t\r\n")
+}
+
+// userDataHashSum returns the hash of the user data stored in the state instead of its contents
+func userDataHashSum(userData string) string {
+	sum := sha1.Sum([]byte(normalizeUserData(userData)))
+	return hex.EncodeToString(sum[:])
+}
+
+// userDataBase64HashSum returns the hash of the decoded contents of base64 encoded user data
+func userDataBase64HashSum(userData string) string {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		// the value is validated as base64, so this only happens with a value coming from the state
+		return userData
+	}
+
+	return userDataHashSum(string(decoded))
+}
+
+// decodeUserDataBase64 decodes base64 encoded user data, the script is sent to the API as a JSON string so the
+// decoded content has to be text, binary content like a gzip compressed config would be mangled
+func decodeUserDataBase64(userData string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		return "", fmt.Errorf("[ERR] user_data_base64 is not valid base64: %s", err)
+	}
+	if !utf8.Valid(decoded) {
+		return "", fmt.Errorf("[ERR] the decoded user_data_base64 is not valid UTF-8 text, binary content like a gzip compressed config isn't supported")
+	}
+
+	return string(decoded), nil
+}
+
+// validateUserDataBase64 checks the user data is base64 encoded text
+func validateUserDataBase64(v interface{}, k string) (ws []string, es []error) {
+	if _, err := decodeUserDataBase64(v.(string)); err != nil {
+		es = append(es, err)
+	}
+	return
+}
+
+// userDataStateFunc stores the hash of the user data in the state
+func userDataStateFunc(v interface{}) string {
+	return userDataHashSum(v.(string))
+}
+
+// userDataBase64StateFunc stores the hash of the decoded base64 user data in the state
+func userDataBase64StateFunc(v interface{}) string {
+	return userDataBase64HashSum(v.(string))
+}
+
+// userDataMatches reports whether the script returned by the Civo API is the user data whose hash is in the state,
+// the API may return the script base64 encoded so both the raw and the decoded script are compared
+func userDataMatches(hash, script string) bool {
+	if userDataHashSum(script) == hash {
+		return true
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(script)
+	return err == nil && userDataHashSum(string(decoded)) == hash
+}
+
+// instanceScript returns the script sent to the Civo API when creating an instance, taken from
+// `user_data`, `user_data_base64` or `script`, whichever is set
+func instanceScript(userData, userDataBase64, script string) (string, error) {
+	switch {
+	case userData != "":
+		return userData, nil
+	case userDataBase64 != "":
+		return decodeUserDataBase64(userDataBase64)
+	default:
+		return script, nil
+	}
+}
+
+// suppressScriptDiff ignores the changes to the script of an existing instance unless `reprovision_on_script_change`
+// is set, the script only runs when the instance is created so there is nothing to update
+func suppressScriptDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && !d.Get("reprovision_on_script_change").(bool)
+}
+
+// scriptCustomizeDiff replaces the instance when its script changes and `reprovision_on_script_change` is set
+func scriptCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && d.HasChange("script") && d.Get("reprovision_on_script_change").(bool) {
+		return d.ForceNew("script")
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceVolumeSchema is the schema of the `volume` blocks attaching volumes to an instance
+func instanceVolumeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Description: "Volumes attached to the instance, they are attached once the instance is active and detached when removed from the block. " +
+			"Don't manage the same volume with a `civo_volume_attachment` resource",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"volume_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the volume to attach",
+				},
+				"mount_point": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Where the volume is expected to be mounted, only kept in the state as a hint for the scripts preparing the instance",
+				},
+			},
+		},
+	}
+}
+
+// attachInstanceVolume attaches the volume to the instance and waits until it's attached
+func attachInstanceVolume(ctx context.Context, apiClient *civogo.Client, instanceID, volumeID string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("attaching the volume %s to instance %s", volumeID, instanceID))
+	if _, err := apiClient.AttachVolume(volumeID, instanceID); err != nil {
+		return fmt.Errorf("[ERR] error attaching volume %s to instance %s: %s", volumeID, instanceID, err)
+	}
+
+	return waitForVolumeStatus(ctx, apiClient, volumeID, []string{"attaching", "available"}, "attached", timeout)
+}
+
+// detachInstanceVolume detaches the volume from the instance and waits until it's available again
+func detachInstanceVolume(ctx context.Context, apiClient *civogo.Client, volumeID string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("detaching the volume %s", volumeID))
+	if _, err := apiClient.DetachVolume(volumeID); err != nil {
+		return fmt.Errorf("[ERR] an error occurred while trying to detach the volume %s: %s", volumeID, err)
+	}
+
+	return waitForVolumeStatus(ctx, apiClient, volumeID, []string{"detaching", "attached"}, "available", timeout)
+}
+
+// waitForVolumeStatus waits until the volume reaches the target status
+func waitForVolumeStatus(ctx context.Context, apiClient *civogo.Client, volumeID string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetVolume(volumeID)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status, nil
+		},
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     3 * time.Second,
+		NotFoundChecks: 10,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for volume (%s) to be %s: %s", volumeID, target, err)
+	}
+
+	return nil
+}
+
+// volumeIDs returns the IDs of the volumes declared in a set of `volume` blocks
+func volumeIDs(set *schema.Set) map[string]bool {
+	ids := map[string]bool{}
+	for _, v := range set.List() {
+		ids[v.(map[string]interface{})["volume_id"].(string)] = true
+	}
+
+	return ids
+}
+
+// updateInstanceVolumes detaches the volumes removed from the `volume` blocks and attaches the new ones
+func updateInstanceVolumes(ctx context.Context, apiClient *civogo.Client, instanceID string, oldSet, newSet *schema.Set, timeout time.Duration) error {
+	oldIDs, newIDs := volumeIDs(oldSet), volumeIDs(newSet)
+
+	for id := range oldIDs {
+		if !newIDs[id] {
+			if err := detachInstanceVolume(ctx, apiClient, id, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	for id := range newIDs {
+		if !oldIDs[id] {
+			if err := attachInstanceVolume(ctx, apiClient, instanceID, id, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// attachedInstanceVolumes returns the `volume` blocks whose volume is still attached to the instance,
+// so a volume detached outside of Terraform shows up as a change and is attached again
+func attachedInstanceVolumes(apiClient *civogo.Client, instanceID string, set *schema.Set) ([]interface{}, error) {
+	if set.Len() == 0 {
+		return []interface{}{}, nil
+	}
+
+	volumes, err := apiClient.ListVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the volumes: %s", err)
+	}
+
+	attached := map[string]bool{}
+	for _, volume := range volumes {
+		if volume.InstanceID == instanceID {
+			attached[volume.ID] = true
+		}
+	}
+
+	blocks := []interface{}{}
+	for _, v := range set.List() {
+		if attached[v.(map[string]interface{})["volume_id"].(string)] {
+			blocks = append(blocks, v)
+		}
+	}
+
+	return blocks, nil
+}
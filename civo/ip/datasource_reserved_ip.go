@@ -2,10 +2,12 @@ package ip
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +20,7 @@ func DataSourceReservedIP() *schema.Resource {
 		Description: strings.Join([]string{
 			"Get information on a reserved IP. This data source provides the region and Instance id as configured on your Civo account.",
 			"This is useful if the reserved IP in question is not managed by Terraform or you need to find the instance the IP is attached to.",
-			"An error will be raised if the provided domain name is not in your Civo account.",
+			"Reserved IPs may be looked up by id, name or ip address, an error will be raised if the reserved IP is not in your Civo account.",
 		}, "\n\n"),
 		Schema: map[string]*schema.Schema{
 			// Computed resource
@@ -26,7 +28,7 @@ func DataSourceReservedIP() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "name"},
+				ExactlyOneOf: []string{"id", "name", "ip"},
 				Description:  "ID for the ip address",
 			},
 			"name": {
@@ -34,18 +36,22 @@ func DataSourceReservedIP() *schema.Resource {
 				Description:  "Name for the ip address",
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "name"},
+				ExactlyOneOf: []string{"id", "name", "ip"},
 			},
 			// Computed resource
 			"ip": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The IP Address requested",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+				ExactlyOneOf: []string{"id", "name", "ip"},
+				Description:  "The IP Address requested, it can be used to look the reserved IP up",
 			},
 			"region": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "The region the ip address is in",
+				Description: "The region the ip address is in, if not declared we use the region declared in the provider",
 			},
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -63,26 +69,31 @@ func DataSourceReservedIP() *schema.Resource {
 }
 
 // function to read a the IP resource
-func dataSourceReservedIPRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func dataSourceReservedIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retriving the ip address %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the ip address %s", d.Id()))
 
 	var foundIP *civogo.IP
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the ip by id")
-		resp, err := apiClient.FindIP(id.(string))
+		tflog.Info(ctx, "Getting the ip by id")
+		resp, err := apiClient.GetIP(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive ip: %s", err)
 		}
 
 		foundIP = resp
-	} else if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the ip by name")
-		resp, err := apiClient.FindIP(name.(string))
+	} else {
+		search, key := d.Get("name").(string), "name"
+		if address, ok := d.GetOk("ip"); ok {
+			search, key = address.(string), "ip"
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Getting the ip by %s", key))
+		resp, err := findReservedIP(apiClient, search)
 		if err != nil {
-			return diag.Errorf("[ERR] failed to retrive ip: %s", err)
+			return diag.FromErr(err)
 		}
 
 		foundIP = resp
@@ -100,3 +111,32 @@ func dataSourceReservedIPRead(_ context.Context, d *schema.ResourceData, m inter
 
 	return nil
 }
+
+// findReservedIP returns the reserved IP whose name or address is exactly the value, FindIP of civogo matches
+// them partially and would return another reserved IP when the value is the prefix of a single one
+func findReservedIP(apiClient *civogo.Client, value string) (*civogo.IP, error) {
+	ips, err := apiClient.ListIPs()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the ips: %s", err)
+	}
+
+	var found []civogo.IP
+	for _, ip := range ips.Items {
+		if ip.Name == value || ip.IP == value {
+			found = append(found, ip)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("[ERR] no reserved ip found with the name or address %s", value)
+	case 1:
+		return &found[0], nil
+	}
+
+	ids := make([]string, 0, len(found))
+	for _, ip := range found {
+		ids = append(ids, ip.ID)
+	}
+	return nil, fmt.Errorf("[ERR] there are %d reserved ips named %s (%s), use the id to look one of them up", len(found), value, strings.Join(ids, ", "))
+}
@@ -23,6 +23,8 @@ func TestAccDataSourceReservedIP_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(datasourceName, "name", name),
 					resource.TestCheckResourceAttrSet(datasourceName, "ip"),
 					resource.TestCheckResourceAttrSet(datasourceName, "region"),
+					resource.TestCheckResourceAttr("data.civo_reserved_ip.by_ip", "name", name),
+					resource.TestCheckResourceAttrPair("data.civo_reserved_ip.by_ip", "id", "civo_reserved_ip.newip", "id"),
 				),
 			},
 		},
@@ -39,5 +41,9 @@ resource "civo_reserved_ip" "newip" {
 data "civo_reserved_ip" "foobar" {
 	name = civo_reserved_ip.newip.name
 }
+
+data "civo_reserved_ip" "by_ip" {
+	ip = civo_reserved_ip.newip.ip
+}
 `, name)
 }
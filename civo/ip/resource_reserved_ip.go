@@ -2,11 +2,13 @@ package ip
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,7 +18,10 @@ import (
 // This can be used to create, read, update, and delete operations for a reserved IP in the infrastructure.
 func ResourceReservedIP() *schema.Resource {
 	return &schema.Resource{
-		Description: "Provides a Civo reserved IP to represent a publicly-accessible static IP addresses that can be mapped to one of your Instancesor Load Balancer.",
+		Description: strings.Join([]string{
+			"Provides a Civo reserved IP to represent a publicly-accessible static IP addresses that can be mapped to one of your Instances or Load Balancer.",
+			"The address stays the same when the instance it's assigned to is replaced, so it can be used in DNS records.",
+		}, "\n\n"),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -28,7 +33,8 @@ func ResourceReservedIP() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The region of the ip",
+				ForceNew:    true,
+				Description: "The region of the ip, changing it creates a new reserved IP",
 			},
 			// Computed resource
 			"ip": {
@@ -44,19 +50,17 @@ func ResourceReservedIP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
 // function to create a new IP resource
 func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] creating the new ip address %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("creating the new ip address %s", d.Get("name").(string)))
 	newIP := &civogo.CreateIPRequest{
 		Name:   d.Get("name").(string),
 		Region: apiClient.Region,
@@ -81,7 +85,7 @@ func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m int
 			}
 			return resp, "ACTIVE", nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 10,
@@ -95,20 +99,14 @@ func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 // function to read a the IP resource
-func resourceReservedIPRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceReservedIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retriving the ip address %s", d.Id())
-	resp, err := apiClient.FindIP(d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the ip address %s", d.Id()))
+	resp, err := apiClient.GetIP(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "reserved ip")
 		}
 
 		return diag.Errorf("[ERR] failed to get the ips: %s", err)
@@ -123,15 +121,10 @@ func resourceReservedIPRead(_ context.Context, d *schema.ResourceData, m interfa
 
 // function to update the IP resource
 func resourceReservedIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	if d.HasChange("name") {
-		log.Printf("[INFO] updating the iop name %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("updating the iop name %s", d.Id()))
 		ipUpdate := &civogo.UpdateIPRequest{
 			Name: d.Get("name").(string),
 		}
@@ -145,15 +138,10 @@ func resourceReservedIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 // function to delete a network
-func resourceReservedIPDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceReservedIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] deleting the ip resource %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("deleting the ip resource %s", d.Id()))
 	_, err := apiClient.DeleteIP(d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the ip resource %s", d.Id())
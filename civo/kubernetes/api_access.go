@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// apiServerPort is the port the Kubernetes API server of a cluster listens on
+	apiServerPort = "6443"
+	// apiAccessRuleLabel is the label of the firewall rule managed through `api_access_cidrs`
+	apiAccessRuleLabel = "kubernetes-api-access"
+)
+
+// isAPIServerRule reports whether the firewall rule is an ingress rule for the port of the Kubernetes API server alone
+func isAPIServerRule(rule civogo.FirewallRule) bool {
+	if rule.Direction != "ingress" || (rule.Protocol != "tcp" && rule.Protocol != "") {
+		return false
+	}
+
+	return rule.Ports == apiServerPort || (rule.StartPort == apiServerPort && (rule.EndPort == apiServerPort || rule.EndPort == ""))
+}
+
+// apiAccessCIDRs returns the CIDRs allowed to reach the Kubernetes API server by the rule managed through
+// `api_access_cidrs`, and whether the firewall has such a rule
+func apiAccessCIDRs(apiClient *civogo.Client, firewallID string) ([]string, bool, error) {
+	rules, err := apiClient.ListFirewallRules(firewallID)
+	if err != nil {
+		return nil, false, fmt.Errorf("[ERR] failed to list the rules of the firewall %s: %s", firewallID, err)
+	}
+
+	for _, rule := range rules {
+		if rule.Label == apiAccessRuleLabel && isAPIServerRule(rule) {
+			cidrs := append([]string{}, rule.Cidr...)
+			sort.Strings(cidrs)
+			return cidrs, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// setAPIAccessCIDRs replaces the ingress rules of the firewall for the Kubernetes API server with a single
+// rule allowing the CIDRs. When there are no CIDRs the API server is opened to everyone again.
+func setAPIAccessCIDRs(ctx context.Context, apiClient *civogo.Client, firewallID string, cidrs []string) error {
+	rules, err := apiClient.ListFirewallRules(firewallID)
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to list the rules of the firewall %s: %s", firewallID, err)
+	}
+
+	for _, rule := range rules {
+		if !isAPIServerRule(rule) {
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("deleting the rule %s of the firewall %s for the kubernetes API server", rule.ID, firewallID))
+		if _, err := apiClient.DeleteFirewallRule(firewallID, rule.ID); err != nil {
+			return fmt.Errorf("[ERR] failed to delete the rule %s of the firewall %s: %s", rule.ID, firewallID, err)
+		}
+	}
+
+	config := &civogo.FirewallRuleConfig{
+		FirewallID: firewallID,
+		Protocol:   "tcp",
+		StartPort:  apiServerPort,
+		EndPort:    apiServerPort,
+		Cidr:       cidrs,
+		Direction:  "ingress",
+		Action:     "allow",
+		Label:      apiAccessRuleLabel,
+	}
+	if len(cidrs) == 0 {
+		config.Cidr = []string{"0.0.0.0/0"}
+		config.Label = "kubernetes-api-server"
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("allowing %v to reach the kubernetes API server in the firewall %s", config.Cidr, firewallID))
+	if _, err := apiClient.NewFirewallRule(config); err != nil {
+		return fmt.Errorf("[ERR] failed to create the rule for the kubernetes API server in the firewall %s: %s", firewallID, err)
+	}
+
+	return nil
+}
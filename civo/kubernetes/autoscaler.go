@@ -0,0 +1,100 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// autoscalerApplication is the marketplace application that runs the cluster autoscaler in the cluster
+const autoscalerApplication = "civo-cluster-autoscaler"
+
+// autoscalerSchema adds to the node pool schema the bounds the cluster autoscaler keeps the number of nodes of the pool within
+func autoscalerSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["min_nodes"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The minimum number of nodes the cluster autoscaler can scale the node pool down to, it must be set together with `max_nodes`",
+	}
+	s["max_nodes"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum number of nodes the cluster autoscaler can scale the node pool up to. When set, changes to the number of nodes made by the autoscaler within the bounds are not reported as a diff of `node_count`",
+	}
+	s["node_count"].DiffSuppressFunc = suppressAutoscaledNodeCount
+
+	return s
+}
+
+// poolAttribute returns the attribute of the same node pool as the given key, the key is either
+// `node_count` in a node pool resource or `pools.0.node_count` in the pool of a cluster
+func poolAttribute(key, attribute string) string {
+	return strings.TrimSuffix(key, "node_count") + attribute
+}
+
+// suppressAutoscaledNodeCount ignores the changes to the number of nodes of an autoscaled pool made
+// by the cluster autoscaler, as long as the number of nodes is within the bounds of the pool
+func suppressAutoscaledNodeCount(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" || old == "" {
+		return false
+	}
+
+	minNodes := d.Get(poolAttribute(k, "min_nodes")).(int)
+	maxNodes := d.Get(poolAttribute(k, "max_nodes")).(int)
+	if minNodes == 0 || maxNodes == 0 {
+		return false
+	}
+
+	count, err := strconv.Atoi(old)
+	if err != nil {
+		return false
+	}
+
+	return count >= minNodes && count <= maxNodes
+}
+
+// autoscalerCustomizeDiff returns a CustomizeDiffFunc checking the autoscaler bounds of the
+// node pool whose attributes start with prefix are complete and include the number of nodes
+func autoscalerCustomizeDiff(prefix string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		minNodes := d.Get(prefix + "min_nodes").(int)
+		maxNodes := d.Get(prefix + "max_nodes").(int)
+		if minNodes == 0 && maxNodes == 0 {
+			return nil
+		}
+
+		if minNodes == 0 || maxNodes == 0 {
+			return fmt.Errorf("[ERR] `min_nodes` and `max_nodes` must be set together to autoscale the node pool")
+		}
+		if minNodes > maxNodes {
+			return fmt.Errorf("[ERR] `min_nodes` (%d) can't be greater than `max_nodes` (%d)", minNodes, maxNodes)
+		}
+
+		if count := d.Get(prefix + "node_count").(int); d.NewValueKnown(prefix+"node_count") && (count < minNodes || count > maxNodes) {
+			return fmt.Errorf("[ERR] `node_count` (%d) must be between `min_nodes` (%d) and `max_nodes` (%d)", count, minNodes, maxNodes)
+		}
+
+		return nil
+	}
+}
+
+// withAutoscalerApplication adds the cluster autoscaler to the comma separated list of applications to install
+func withAutoscalerApplication(applications string) string {
+	for _, app := range strings.Split(applications, ",") {
+		if strings.EqualFold(strings.TrimSpace(app), autoscalerApplication) {
+			return applications
+		}
+	}
+
+	if applications == "" {
+		return autoscalerApplication
+	}
+
+	return applications + "," + autoscalerApplication
+}
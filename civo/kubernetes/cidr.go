@@ -0,0 +1,73 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// clusterCIDRs are the pod and service CIDRs of the clusters of every type, the Civo API doesn't allow to change them
+var clusterCIDRs = map[string]map[string]string{
+	"k3s": {
+		"pod":     "10.42.0.0/16",
+		"service": "10.43.0.0/16",
+	},
+	"talos": {
+		"pod":     "10.244.0.0/16",
+		"service": "10.96.0.0/12",
+	},
+}
+
+// networkCIDRCustomizeDiff refuses to plan a new cluster in a network whose CIDR overlaps the pod or service
+// CIDR of the cluster, as the traffic to the network would be silently routed inside the cluster. The check
+// is skipped when the network can't be read.
+func networkCIDRCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("network_id") || !d.NewValueKnown("cluster_type") || d.Get("network_id").(string) == "" {
+		return nil
+	}
+
+	clusterType := d.Get("cluster_type").(string)
+	if clusterType == "" {
+		clusterType = "k3s"
+	}
+	cidrs, ok := clusterCIDRs[clusterType]
+	if !ok {
+		return nil
+	}
+
+	_, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	networkID := d.Get("network_id").(string)
+	network, err := apiClient.GetNetwork(networkID)
+	if err != nil {
+		tflog.Warn(ctx, "unable to read the network to validate its CIDR", map[string]interface{}{
+			"network_id": networkID,
+			"error":      err.Error(),
+		})
+		return nil
+	}
+
+	_, networkCIDR, err := net.ParseCIDR(network.CIDR)
+	if err != nil {
+		return nil
+	}
+
+	for _, name := range []string{"pod", "service"} {
+		_, clusterCIDR, err := net.ParseCIDR(cidrs[name])
+		if err != nil {
+			continue
+		}
+		if utils.CIDRsOverlap(networkCIDR, clusterCIDR) {
+			return fmt.Errorf("[ERR] the CIDR %s of the network %s overlaps the %s CIDR %s of %s clusters, use a network with another CIDR", network.CIDR, network.Label, name, cidrs[name], clusterType)
+		}
+	}
+
+	return nil
+}
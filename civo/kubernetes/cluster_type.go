@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// clusterTypeK3s is the default type of the clusters
+	clusterTypeK3s = "k3s"
+	// clusterTypeTalos clusters run Talos Linux, they don't support marketplace applications
+	clusterTypeTalos = "talos"
+)
+
+// talosCNIs are the CNI plugins supported by talos clusters
+var talosCNIs = []string{"flannel"}
+
+// plannedClusterType returns the type of the cluster in the plan, k3s when it isn't set
+func plannedClusterType(d *schema.ResourceDiff) string {
+	if clusterType := d.Get("cluster_type").(string); clusterType != "" {
+		return clusterType
+	}
+
+	return clusterTypeK3s
+}
+
+// clusterTypeCustomizeDiff refuses to plan the options the type of the cluster doesn't support, so they don't
+// fail when the cluster is created: talos clusters have no marketplace applications and only support some CNI
+// plugins, and the kubernetes version has to be one of the versions of the type
+func clusterTypeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("cluster_type") {
+		return nil
+	}
+	clusterType := plannedClusterType(d)
+
+	if clusterType == clusterTypeTalos {
+		if d.NewValueKnown("applications") && d.Get("applications").(string) != "" {
+			return fmt.Errorf("[ERR] talos clusters don't support marketplace applications, remove `applications` or use a k3s cluster")
+		}
+		if d.Get("enable_autoscaler").(bool) {
+			return fmt.Errorf("[ERR] talos clusters don't support marketplace applications, the autoscaler can't be enabled")
+		}
+		if cni := d.Get("cni").(string); d.Id() == "" && d.NewValueKnown("cni") && cni != "" && !slices.Contains(talosCNIs, cni) {
+			return fmt.Errorf("[ERR] the CNI plugin %q isn't supported by talos clusters, the supported plugins are: %s", cni, strings.Join(talosCNIs, ", "))
+		}
+	}
+
+	if !d.NewValueKnown("kubernetes_version") || d.Get("kubernetes_version").(string) == "" || (d.Id() != "" && !d.HasChange("kubernetes_version")) {
+		return nil
+	}
+
+	meta, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	versions, err := utils.Cached(meta, "kubernetes_versions", apiClient.ListAvailableKubernetesVersions)
+	if err != nil {
+		tflog.Warn(ctx, "unable to list the kubernetes versions to validate the version of the cluster", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	kubernetesVersion := d.Get("kubernetes_version").(string)
+	if available := clusterTypeVersions(versions, clusterType); !slices.Contains(available, kubernetesVersion) {
+		return fmt.Errorf("[ERR] the kubernetes version %s isn't available for %s clusters, the available versions are: %s", kubernetesVersion, clusterType, strings.Join(available, ", "))
+	}
+
+	return nil
+}
+
+// clusterTypeVersions returns the kubernetes versions available for the type of cluster
+func clusterTypeVersions(versions []civogo.KubernetesVersion, clusterType string) []string {
+	available := []string{}
+	for _, v := range versions {
+		if v.ClusterType == clusterType || (v.ClusterType == "" && clusterType == clusterTypeK3s) {
+			available = append(available, v.Version)
+		}
+	}
+
+	return available
+}
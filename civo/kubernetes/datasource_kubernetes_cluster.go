@@ -2,10 +2,12 @@ package kubernetes
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,7 +34,7 @@ func DataSourceKubernetesCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
 				ExactlyOneOf: []string{"id", "name"},
-				Description:  "The name of the Kubernetes Cluster",
+				Description:  "The name of the Kubernetes Cluster, it must match the whole name of the cluster",
 			},
 			"region": {
 				Type:         schema.TypeString,
@@ -75,6 +77,7 @@ func DataSourceKubernetesCluster() *schema.Resource {
 				Description: "A list of application installed",
 			},
 			"installed_applications": dataSourceApplicationSchema(),
+			"conditions":             conditionsSchema(),
 			"pools": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -153,28 +156,23 @@ func dataSourceApplicationSchema() *schema.Schema {
 	}
 }
 
-func dataSourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func dataSourceKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	var foundCluster *civogo.KubernetesCluster
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the kubernetes Cluster by id")
+		tflog.Info(ctx, "Getting the kubernetes Cluster by id")
 		kubeCluster, err := apiClient.FindKubernetesCluster(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive kubernetes cluster: %s", err)
 		}
 		foundCluster = kubeCluster
 	} else if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the kubernetes Cluster by name")
-		kubeCluster, err := apiClient.FindKubernetesCluster(name.(string))
+		tflog.Info(ctx, "Getting the kubernetes Cluster by name")
+		kubeCluster, err := findKubernetesClusterByName(apiClient, name.(string))
 		if err != nil {
-			return diag.Errorf("[ERR] failed to retrive kubernetes cluster: %s", err)
+			return diag.FromErr(err)
 		}
 
 		foundCluster = kubeCluster
@@ -204,6 +202,10 @@ func dataSourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("[ERR] error retrieving the installed application for kubernetes cluster error: %#v", err)
 	}
 
+	if err := d.Set("conditions", flattenConditions(foundCluster.Conditions)); err != nil {
+		return diag.Errorf("[ERR] error retrieving the conditions for kubernetes cluster error: %#v", err)
+	}
+
 	return nil
 }
 
@@ -230,3 +232,43 @@ func flattenDataSourceNodePool(cluster *civogo.KubernetesCluster) []interface{}
 
 	return flattenedPool
 }
+
+// findKubernetesClusterByName returns the cluster with the exact name, unlike FindKubernetesCluster
+// a name that is only part of the name of a cluster doesn't match it
+func findKubernetesClusterByName(apiClient *civogo.Client, name string) (*civogo.KubernetesCluster, error) {
+	clusters, err := apiClient.ListKubernetesClusters()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the kubernetes clusters: %s", err)
+	}
+
+	found := []civogo.KubernetesCluster{}
+	for _, cluster := range clusters.Items {
+		if strings.EqualFold(cluster.Name, name) {
+			found = append(found, cluster)
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("[ERR] no kubernetes cluster named %s was found in the region %s", name, apiClient.Region)
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("[ERR] more than one kubernetes cluster named %s was found in the region %s, use the id instead", name, apiClient.Region)
+	}
+
+	return &found[0], nil
+}
+
+// resolveKubernetesClusterID returns the ID of the cluster with the given ID or exact name,
+// so the clusters can be referred to by name when importing
+func resolveKubernetesClusterID(apiClient *civogo.Client, idOrName string) (string, error) {
+	if cluster, err := apiClient.GetKubernetesCluster(idOrName); err == nil {
+		return cluster.ID, nil
+	}
+
+	cluster, err := findKubernetesClusterByName(apiClient, idOrName)
+	if err != nil {
+		return "", err
+	}
+
+	return cluster.ID, nil
+}
@@ -0,0 +1,158 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// clusterInstance is a node of a cluster together with the pool it belongs to and its private IP
+type clusterInstance struct {
+	civogo.KubernetesInstance
+	PoolID    string
+	PrivateIP string
+}
+
+// DataSourceKubernetesClusterInstances Data source to get and filter the nodes of a kubernetes cluster
+func DataSourceKubernetesClusterInstances() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on the nodes of a Civo Kubernetes cluster, with the ability to filter and sort the results. If no filters are specified, all the nodes of every pool of the cluster will be returned.",
+			"This can be used to enumerate the nodes for monitoring or inventory without talking to the Kubernetes API.",
+		}, "\n\n"),
+		RecordSchema: kubernetesClusterInstancesSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the Kubernetes cluster",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region of the cluster, if not declared we use the region declared in the provider",
+			},
+		},
+		ResultAttributeName: "instances",
+		FlattenRecord:       flattenDataSourceKubernetesClusterInstances,
+		GetRecords:          getDataSourceKubernetesClusterInstances,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceKubernetesClusterInstances(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
+
+	cluster, err := apiClient.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to retrieve the kubernetes cluster %s: %s", clusterID, err)
+	}
+
+	// the nodes of the cluster don't have their private IP, it's read from the instances of the region
+	allInstances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the instances: %s", err)
+	}
+	privateIPs := map[string]string{}
+	for _, instance := range allInstances {
+		privateIPs[instance.ID] = instance.PrivateIP
+	}
+
+	var instances []interface{}
+	for _, pool := range cluster.Pools {
+		for _, node := range pool.Instances {
+			instances = append(instances, clusterInstance{
+				KubernetesInstance: node,
+				PoolID:             pool.ID,
+				PrivateIP:          privateIPs[node.ID],
+			})
+		}
+	}
+
+	return instances, nil
+}
+
+func flattenDataSourceKubernetesClusterInstances(instance, _ interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+	i := instance.(clusterInstance)
+
+	flattenedInstance := map[string]interface{}{}
+	flattenedInstance["id"] = i.ID
+	flattenedInstance["hostname"] = i.Hostname
+	flattenedInstance["pool_id"] = i.PoolID
+	flattenedInstance["size"] = i.Size
+	flattenedInstance["private_ip"] = i.PrivateIP
+	flattenedInstance["public_ip"] = i.PublicIP
+	flattenedInstance["status"] = i.Status
+	flattenedInstance["cpu_cores"] = i.CPUCores
+	flattenedInstance["ram_mb"] = i.RAMMegabytes
+	flattenedInstance["disk_gb"] = i.DiskGigabytes
+	flattenedInstance["created_at"] = i.CreatedAt.UTC().String()
+
+	return flattenedInstance, nil
+}
+
+func kubernetesClusterInstancesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Instance ID of the node",
+		},
+		"hostname": {
+			Type:        schema.TypeString,
+			Description: "Hostname of the node",
+		},
+		"pool_id": {
+			Type:        schema.TypeString,
+			Description: "ID (the label) of the node pool of the node",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Description: "Size of the node",
+		},
+		"private_ip": {
+			Type:        schema.TypeString,
+			Description: "Private IP address of the node",
+		},
+		"public_ip": {
+			Type:        schema.TypeString,
+			Description: "Public IP address of the node, empty when the node pool has no public IPs",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the node",
+		},
+		"cpu_cores": {
+			Type:        schema.TypeInt,
+			Description: "Number of CPU cores of the node",
+		},
+		"ram_mb": {
+			Type:        schema.TypeInt,
+			Description: "Size of the RAM of the node in MB",
+		},
+		"disk_gb": {
+			Type:        schema.TypeInt,
+			Description: "Size of the disk of the node in GB",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "Creation date of the node",
+		},
+	}
+}
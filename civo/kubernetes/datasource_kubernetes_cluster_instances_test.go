@@ -0,0 +1,59 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoKubernetesClusterInstances_basic(t *testing.T) {
+	datasourceName := "data.civo_kubernetes_cluster_instances.foobar"
+	name := acctest.RandomWithPrefix("k8s")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoKubernetesClusterInstancesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instances.0.pool_id", "civo_kubernetes_cluster.my-cluster", "pools.0.label"),
+					resource.TestCheckResourceAttr(datasourceName, "instances.0.size", "g4s.kube.small"),
+					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.hostname"),
+					resource.TestCheckResourceAttrSet(datasourceName, "instances.0.private_ip"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoKubernetesClusterInstancesConfig(name string) string {
+	return fmt.Sprintf(`
+data "civo_firewall" "default" {
+	name = "default-default"
+	region = "LON1"
+}
+
+resource "civo_kubernetes_cluster" "my-cluster" {
+	name = "%s"
+	firewall_id = data.civo_firewall.default.id
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}
+
+data "civo_kubernetes_cluster_instances" "foobar" {
+	cluster_id = civo_kubernetes_cluster.my-cluster.id
+	region = "LON1"
+	sort {
+		key = "hostname"
+	}
+}
+`, name)
+}
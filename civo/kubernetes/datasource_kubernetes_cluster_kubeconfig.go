@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfig is the part of a kubeconfig file the data source reads
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// DataSourceKubernetesClusterKubeconfig function returns a schema.Resource that represents the kubeconfig of a Kubernetes cluster.
+// The kubeconfig is fetched every time the data source is read, so it can be given to the kubernetes and helm providers.
+func DataSourceKubernetesClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Description: strings.Join([]string{
+			"Get the kubeconfig of a Civo Kubernetes cluster.",
+			"The kubeconfig is fetched from the API every time the data source is read, so unlike the `kubeconfig` of the `civo_kubernetes_cluster` resource it doesn't go stale in the state. The credentials are also provided as separate attributes to configure the kubernetes and helm providers.",
+		}, "\n\n"),
+		ReadContext: dataSourceKubernetesClusterKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the Kubernetes cluster",
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The region of the cluster, if not declared we use the region declared in the provider",
+			},
+			// computed attributes
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubeconfig of the cluster",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the API server of the cluster",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate authority of the cluster",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded client certificate used to authenticate against the cluster",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded client key used to authenticate against the cluster",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token used to authenticate against the cluster, if the kubeconfig uses one",
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+	clusterID := d.Get("cluster_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("retrieving the kubeconfig of the kubernetes cluster %s", clusterID))
+	cluster, err := apiClient.GetKubernetesCluster(clusterID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to retrive kubernetes cluster: %s", err)
+	}
+
+	if cluster.KubeConfig == "" {
+		return diag.Errorf("[ERR] the kubernetes cluster %s doesn't have a kubeconfig yet, it's %s", cluster.ID, cluster.Status)
+	}
+
+	d.SetId(cluster.ID)
+	d.Set("region", apiClient.Region)
+	d.Set("kubeconfig", cluster.KubeConfig)
+
+	config := kubeconfig{}
+	if err := yaml.Unmarshal([]byte(cluster.KubeConfig), &config); err != nil {
+		return diag.Errorf("[ERR] failed to parse the kubeconfig of the kubernetes cluster %s: %s", cluster.ID, err)
+	}
+
+	clusterName, userName := "", ""
+	for _, c := range config.Contexts {
+		if c.Name == config.CurrentContext || len(config.Contexts) == 1 {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+			break
+		}
+	}
+
+	for _, c := range config.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		d.Set("host", c.Cluster.Server)
+		caCertificate, err := base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return diag.Errorf("[ERR] failed to decode the certificate authority of the kubernetes cluster %s: %s", cluster.ID, err)
+		}
+		d.Set("cluster_ca_certificate", string(caCertificate))
+	}
+
+	for _, u := range config.Users {
+		if u.Name != userName {
+			continue
+		}
+		clientCertificate, err := base64.StdEncoding.DecodeString(u.User.ClientCertificateData)
+		if err != nil {
+			return diag.Errorf("[ERR] failed to decode the client certificate of the kubernetes cluster %s: %s", cluster.ID, err)
+		}
+		clientKey, err := base64.StdEncoding.DecodeString(u.User.ClientKeyData)
+		if err != nil {
+			return diag.Errorf("[ERR] failed to decode the client key of the kubernetes cluster %s: %s", cluster.ID, err)
+		}
+		d.Set("client_certificate", string(clientCertificate))
+		d.Set("client_key", string(clientKey))
+		d.Set("token", u.User.Token)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoKubernetesClusterKubeconfig_basic(t *testing.T) {
+	datasourceName := "data.civo_kubernetes_cluster_kubeconfig.foobar"
+	name := acctest.RandomWithPrefix("k8s")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoKubernetesClusterKubeconfigConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "cluster_id", "civo_kubernetes_cluster.my-cluster", "id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "kubeconfig"),
+					resource.TestCheckResourceAttrSet(datasourceName, "host"),
+					resource.TestCheckResourceAttrSet(datasourceName, "cluster_ca_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoKubernetesClusterKubeconfigConfig(name string) string {
+	return fmt.Sprintf(`
+data "civo_firewall" "default" {
+	name = "default-default"
+	region = "LON1"
+}
+
+resource "civo_kubernetes_cluster" "my-cluster" {
+	name = "%s"
+	firewall_id = data.civo_firewall.default.id
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}
+
+data "civo_kubernetes_cluster_kubeconfig" "foobar" {
+	cluster_id = civo_kubernetes_cluster.my-cluster.id
+}
+`, name)
+}
@@ -0,0 +1,162 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceKubernetesClusters Data source to get and filter all kubernetes clusters with filter
+func DataSourceKubernetesClusters() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on Kubernetes clusters for use in other resources, with the ability to filter and sort the results. If no filters are specified, all clusters of the region will be returned.",
+			"Note: You can use the `civo_kubernetes_cluster` data source to obtain metadata about a single cluster if you already know the id or unique name to retrieve.",
+		}, "\n\n"),
+		RecordSchema: kubernetesClustersSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all clusters will be from the provided region",
+			},
+		},
+		ResultAttributeName: "clusters",
+		FlattenRecord:       flattenDataSourceKubernetesClusters,
+		GetRecords:          getDataSourceKubernetesClusters,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceKubernetesClusters(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
+
+	var clusters []interface{}
+	partialClusters, err := apiClient.ListKubernetesClusters()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving kubernetes clusters: %s", err)
+	}
+
+	for _, partialCluster := range partialClusters.Items {
+		clusters = append(clusters, partialCluster)
+	}
+
+	return clusters, nil
+}
+
+func flattenDataSourceKubernetesClusters(cluster, m interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+	// the clusters are listed in the region of the provider when the data source doesn't declare one
+	region = utils.ClientForRegion(m, region).Region
+
+	c := cluster.(civogo.KubernetesCluster)
+
+	nodeCount := 0
+	for _, pool := range c.Pools {
+		nodeCount += pool.Count
+	}
+
+	flattenedCluster := map[string]interface{}{}
+	flattenedCluster["id"] = c.ID
+	flattenedCluster["name"] = c.Name
+	flattenedCluster["region"] = region
+	flattenedCluster["kubernetes_version"] = c.KubernetesVersion
+	flattenedCluster["cluster_type"] = c.ClusterType
+	flattenedCluster["cni"] = c.CNIPlugin
+	flattenedCluster["network_id"] = c.NetworkID
+	flattenedCluster["firewall_id"] = c.FirewallID
+	flattenedCluster["tags"] = c.Tags
+	flattenedCluster["node_count"] = nodeCount
+	flattenedCluster["status"] = c.Status
+	flattenedCluster["ready"] = c.Ready
+	flattenedCluster["api_endpoint"] = c.APIEndPoint
+	flattenedCluster["master_ip"] = c.MasterIP
+	flattenedCluster["dns_entry"] = c.DNSEntry
+	flattenedCluster["created_at"] = c.CreatedAt.UTC().String()
+
+	return flattenedCluster, nil
+}
+
+func kubernetesClustersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "ID of the cluster",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the cluster",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "Region of the cluster",
+		},
+		"kubernetes_version": {
+			Type:        schema.TypeString,
+			Description: "Kubernetes version of the cluster",
+		},
+		"cluster_type": {
+			Type:        schema.TypeString,
+			Description: "Type of the cluster, `k3s` or `talos`",
+		},
+		"cni": {
+			Type:        schema.TypeString,
+			Description: "CNI plugin of the cluster",
+		},
+		"network_id": {
+			Type:        schema.TypeString,
+			Description: "Network ID of the cluster",
+		},
+		"firewall_id": {
+			Type:        schema.TypeString,
+			Description: "Firewall ID of the cluster",
+		},
+		"tags": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Tags of the cluster",
+		},
+		"node_count": {
+			Type:        schema.TypeInt,
+			Description: "Number of nodes of all the pools of the cluster",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the cluster",
+		},
+		"ready": {
+			Type:        schema.TypeBool,
+			Description: "Whether the cluster is ready",
+		},
+		"api_endpoint": {
+			Type:        schema.TypeString,
+			Description: "API server endpoint of the cluster",
+		},
+		"master_ip": {
+			Type:        schema.TypeString,
+			Description: "IP address of the master node of the cluster",
+		},
+		"dns_entry": {
+			Type:        schema.TypeString,
+			Description: "DNS name of the cluster",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "Creation date of the cluster",
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoKubernetesClusters_basic(t *testing.T) {
+	datasourceName := "data.civo_kubernetes_clusters.foobar"
+	name := acctest.RandomWithPrefix("k8s")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoKubernetesClustersConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "clusters.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "clusters.0.name", name),
+					resource.TestCheckResourceAttr(datasourceName, "clusters.0.node_count", "2"),
+					resource.TestCheckResourceAttrPair(datasourceName, "clusters.0.id", "civo_kubernetes_cluster.my-cluster", "id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "clusters.0.kubernetes_version"),
+					resource.TestCheckResourceAttrSet(datasourceName, "clusters.0.api_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoKubernetesClustersConfig(name string) string {
+	return fmt.Sprintf(`
+data "civo_firewall" "default" {
+	name = "default-default"
+	region = "LON1"
+}
+
+resource "civo_kubernetes_cluster" "my-cluster" {
+	name = "%s"
+	firewall_id = data.civo_firewall.default.id
+	tags = ["%s"]
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}
+
+data "civo_kubernetes_clusters" "foobar" {
+	region = "LON1"
+	filter {
+		key = "tags"
+		values = tolist(civo_kubernetes_cluster.my-cluster.tags)
+	}
+}
+`, name, name)
+}
@@ -0,0 +1,141 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceKubernetesMarketplaceApplications Data source to get and filter the applications of the kubernetes marketplace
+func DataSourceKubernetesMarketplaceApplications() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on the applications of the Civo Kubernetes marketplace, with the ability to filter and sort the results. If no filters are specified, all the applications will be returned.",
+			"The names and plans can be used in the `applications` of a `civo_kubernetes_cluster` or in a `civo_kubernetes_application`.",
+		}, "\n\n"),
+		RecordSchema: kubernetesMarketplaceApplicationsSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all applications will be from the provided region",
+			},
+		},
+		ResultAttributeName: "applications",
+		FlattenRecord:       flattenDataSourceKubernetesMarketplaceApplications,
+		GetRecords:          getDataSourceKubernetesMarketplaceApplications,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceKubernetesMarketplaceApplications(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
+
+	partialApps, err := marketplaceApplications(m, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []interface{}
+	for _, partialApp := range partialApps {
+		apps = append(apps, partialApp)
+	}
+
+	return apps, nil
+}
+
+func flattenDataSourceKubernetesMarketplaceApplications(app, _ interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+	a := app.(civogo.KubernetesMarketplaceApplication)
+
+	plans := make([]interface{}, 0, len(a.Plans))
+	planConfiguration := map[string]interface{}{}
+	for _, plan := range a.Plans {
+		plans = append(plans, plan.Label)
+
+		keys := make([]string, 0, len(plan.Configuration))
+		for key := range plan.Configuration {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		planConfiguration[plan.Label] = strings.Join(keys, ",")
+	}
+
+	flattenedApp := map[string]interface{}{}
+	flattenedApp["name"] = a.Name
+	flattenedApp["title"] = a.Title
+	flattenedApp["version"] = a.Version
+	flattenedApp["category"] = a.Category
+	flattenedApp["default"] = a.Default
+	flattenedApp["dependencies"] = a.Dependencies
+	flattenedApp["maintainer"] = a.Maintainer
+	flattenedApp["description"] = a.Description
+	flattenedApp["url"] = a.URL
+	flattenedApp["plans"] = plans
+	flattenedApp["plan_configuration"] = planConfiguration
+
+	return flattenedApp, nil
+}
+
+func kubernetesMarketplaceApplicationsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the application, to use in the `applications` of a cluster",
+		},
+		"title": {
+			Type:        schema.TypeString,
+			Description: "Title of the application",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Description: "Version of the application",
+		},
+		"category": {
+			Type:        schema.TypeString,
+			Description: "Category of the application",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Description: "Whether the application is installed by default in new clusters",
+		},
+		"dependencies": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Applications the application depends on",
+		},
+		"maintainer": {
+			Type:        schema.TypeString,
+			Description: "Maintainer of the application",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "Description of the application",
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Description: "URL of the application",
+		},
+		"plans": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Plans of the application, one of them has to be chosen with `name:plan` when the application has plans",
+		},
+		"plan_configuration": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Comma separated configuration keys set by every plan of the application, by plan",
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoKubernetesMarketplaceApplications_basic(t *testing.T) {
+	datasourceName := "data.civo_kubernetes_marketplace_applications.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoKubernetesMarketplaceApplicationsConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "applications.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "applications.0.name", "metrics-server"),
+					resource.TestCheckResourceAttrSet(datasourceName, "applications.0.version"),
+					resource.TestCheckResourceAttrSet(datasourceName, "applications.0.category"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoKubernetesMarketplaceApplicationsConfig() string {
+	return `
+data "civo_kubernetes_marketplace_applications" "foobar" {
+	region = "LON1"
+	filter {
+		key = "name"
+		values = ["metrics-server"]
+	}
+}
+`
+}
@@ -1,10 +1,15 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,26 +17,118 @@ import (
 // This can be used to query and retrieve details about a specific Kubernetes version in the infrastructure.
 func DataSourceKubernetesVersion() *schema.Resource {
 	dataListConfig := &datalist.ResourceConfig{
-		Description:         "Provides access to the available Civo Kubernetes versions, with the ability to filter the results.",
-		RecordSchema:        kubernetesVersionSchema(),
+		Description:  "Provides access to the available Civo Kubernetes versions, with the ability to filter the results.",
+		RecordSchema: kubernetesVersionSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all versions will be from the provided region",
+			},
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateVersionConstraint,
+				Description:  "If used, only the versions matching the version constraint are returned, for example `~> 1.29`. The distribution suffix of the versions, like `-k3s1`, is ignored",
+			},
+		},
 		ResultAttributeName: "versions",
 		FlattenRecord:       flattenKubernetesVersion,
 		GetRecords:          getKubernetesVersions,
 	}
 
-	return datalist.NewResource(dataListConfig)
+	r := datalist.NewResource(dataListConfig)
+	r.Schema["latest_stable"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The most recent stable version of the returned versions, empty if none of them is stable",
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := read(ctx, d, m); diags.HasError() {
+			return diags
+		}
+
+		d.Set("latest_stable", latestStableKubernetesVersion(d.Get("versions").([]interface{})))
+		return nil
+	}
+
+	return r
+}
+
+// kubernetesSemver parses a Kubernetes version ignoring its distribution suffix, so `1.29.2-k3s1`
+// is compared as `1.29.2` instead of being taken as a pre-release
+func kubernetesSemver(v string) (*version.Version, error) {
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+
+	return version.NewVersion(strings.TrimPrefix(v, "v"))
+}
+
+// validateVersionConstraint checks the version constraint can be parsed
+func validateVersionConstraint(v interface{}, k string) (ws []string, es []error) {
+	if _, err := version.NewConstraint(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q isn't a valid version constraint: %s", k, err))
+	}
+
+	return ws, es
 }
 
-func getKubernetesVersions(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+// latestStableKubernetesVersion returns the most recent version of the list marked as stable
+func latestStableKubernetesVersion(versions []interface{}) string {
+	var latest *version.Version
+	latestVersion := ""
+	for _, raw := range versions {
+		v := raw.(map[string]interface{})
+		if !v["stable"].(bool) {
+			continue
+		}
+
+		parsed, err := kubernetesSemver(v["version"].(string))
+		if err != nil {
+			continue
+		}
+		if latest == nil || parsed.GreaterThan(latest) {
+			latest = parsed
+			latestVersion = v["version"].(string)
+		}
+	}
+
+	return latestVersion
+}
+
+func getKubernetesVersions(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
 
 	versions := []interface{}{}
-	partialVersions, err := apiClient.ListAvailableKubernetesVersions()
+	partialVersions, err := utils.Cached(m, "kubernetes_versions/"+apiClient.Region, apiClient.ListAvailableKubernetesVersions)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving all versions: %s", err)
 	}
 
+	var constraint version.Constraints
+	if raw, ok := extra["version_constraint"].(string); ok && raw != "" {
+		constraint, err = version.NewConstraint(raw)
+		if err != nil {
+			return nil, fmt.Errorf("[ERR] invalid version constraint %q: %s", raw, err)
+		}
+	}
+
 	for _, partialSize := range partialVersions {
+		if constraint != nil {
+			parsed, err := kubernetesSemver(partialSize.Version)
+			if err != nil || !constraint.Check(parsed) {
+				continue
+			}
+		}
 		versions = append(versions, partialSize)
 	}
 
@@ -47,6 +144,7 @@ func flattenKubernetesVersion(version, _ interface{}, _ map[string]interface{})
 	flattenedVersion["label"] = fmt.Sprintf("v%s", s.Version)
 	flattenedVersion["type"] = s.ClusterType
 	flattenedVersion["default"] = s.Default
+	flattenedVersion["stable"] = s.Type == "stable"
 	return flattenedVersion, nil
 }
 
@@ -73,5 +171,10 @@ func kubernetesVersionSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "If is the default version used in all cluster, this will return `true`",
 		},
+		"stable": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "If the version is a stable release, this will return `true`",
+		},
 	}
 }
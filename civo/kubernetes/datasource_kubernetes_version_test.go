@@ -45,6 +45,24 @@ func TestAccDataSourceCivoKubernetesVersion_WithFilter(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceCivoKubernetesVersion_WithConstraint(t *testing.T) {
+	datasourceName := "data.civo_kubernetes_version.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoKubernetesVersionConfigWithConstraint(),
+				Check: resource.ComposeTestCheckFunc(
+					DataSourceCivoKubernetesVersionExist(datasourceName),
+					resource.TestCheckResourceAttrSet(datasourceName, "latest_stable"),
+				),
+			},
+		},
+	})
+}
+
 func DataSourceCivoKubernetesVersionExist(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -123,3 +141,11 @@ data "civo_kubernetes_version" "foobar" {
 }
 `
 }
+
+func DataSourceCivoKubernetesVersionConfigWithConstraint() string {
+	return `
+data "civo_kubernetes_version" "foobar" {
+	version_constraint = ">= 1.0"
+}
+`
+}
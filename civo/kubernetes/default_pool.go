@@ -0,0 +1,81 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/civo/civogo"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// poolLabelConfigured reports whether the label of the pool is set in the configuration, path is
+// the path of the pool in the configuration or empty for the node pool resource
+func poolLabelConfigured(d interface{ GetRawConfig() cty.Value }, path ...string) bool {
+	pool := d.GetRawConfig()
+	for _, attr := range path {
+		pool = pool.GetAttr(attr)
+		if pool.IsNull() || !pool.IsKnown() || pool.LengthInt() == 0 {
+			return false
+		}
+		pool = pool.Index(cty.NumberIntVal(0))
+	}
+
+	label := pool.GetAttr("label")
+	return label.IsKnown() && !label.IsNull()
+}
+
+// poolLabelCustomizeDiff returns a CustomizeDiffFunc refusing to plan a new size for the pool whose attributes
+// start with prefix when its label is set in the configuration and isn't changed, as the pool is replaced and
+// the new pool can't have the same label. path is the path of the pool in the configuration.
+func poolLabelCustomizeDiff(prefix string, path ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() == "" || !d.HasChange(prefix+"size") || d.HasChange(prefix+"label") || !poolLabelConfigured(d, path...) {
+			return nil
+		}
+
+		return fmt.Errorf("[ERR] the node pool %s has to be replaced to change its size, change its label too as the new pool can't have the same label", d.Get(prefix+"label").(string))
+	}
+}
+
+// replacementPoolID returns the label of the pool replacing the pool oldID, a new label is generated when
+// the label isn't changed as the new pool can't have the same label
+func replacementPoolID(oldID, newID string) string {
+	if newID != oldID {
+		return newID
+	}
+
+	return uuid.NewString()
+}
+
+// replaceDefaultNodePool replaces the pool of the cluster with a new pool with the configuration of newPool.
+// The label of newPool is set to the label of the new pool.
+func replaceDefaultNodePool(ctx context.Context, apiClient *civogo.Client, d *schema.ResourceData, oldPool, newPool map[string]interface{}) error {
+	oldID := oldPool["label"].(string)
+	newID := replacementPoolID(oldID, newPool["label"].(string))
+
+	labels := map[string]string{}
+	for k, v := range newPool["labels"].(map[string]interface{}) {
+		labels[k] = v.(string)
+	}
+
+	err := createReplacementPool(ctx, apiClient, d.Id(), &civogo.KubernetesClusterPoolUpdateConfig{
+		ID:               newID,
+		Count:            newPool["node_count"].(int),
+		Size:             newPool["size"].(string),
+		Labels:           labels,
+		Taints:           expandTaints(newPool["taint"].(*schema.Set)),
+		PublicIPNodePool: newPool["public_ip_node_pool"].(bool),
+		Region:           apiClient.Region,
+	}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	// the pool is updated and read by its label from now on, even if the old pool can't be deleted
+	newPool["label"] = newID
+	d.Set("pools", []interface{}{newPool})
+
+	return deleteReplacedPool(ctx, apiClient, d.Id(), oldID, d.Timeout(schema.TimeoutUpdate))
+}
@@ -0,0 +1,35 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// setClusterFirewall moves the nodes of the cluster to the firewall, the firewall has to be in the network of
+// the cluster. The firewall of the cluster is read back as the API ignores the firewalls it can't use.
+func setClusterFirewall(ctx context.Context, apiClient *civogo.Client, clusterID, networkID, firewallID string) error {
+	firewall, err := apiClient.FindFirewall(firewallID)
+	if err != nil {
+		return fmt.Errorf("[ERR] unable to find firewall - %s", err)
+	}
+	if firewall.NetworkID != networkID {
+		return fmt.Errorf("[ERR] firewall %s is not part of network %s", firewall.ID, networkID)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("moving the kubernetes cluster %s to the firewall %s", clusterID, firewall.ID))
+	resp, err := apiClient.UpdateKubernetesCluster(clusterID, &civogo.KubernetesClusterConfig{
+		InstanceFirewall: firewall.ID,
+		Region:           apiClient.Region,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to move the kubernetes cluster %s to the firewall %s: %s", clusterID, firewall.ID, err)
+	}
+	if resp.FirewallID != firewall.ID {
+		return fmt.Errorf("[ERR] the kubernetes cluster %s is still using the firewall %s, it wasn't moved to the firewall %s", clusterID, resp.FirewallID, firewall.ID)
+	}
+
+	return nil
+}
@@ -40,7 +40,7 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Computed:    true,
-			Description: "Node pool belongs to the public ip node pool",
+			Description: "If true, the nodes of the pool get a public IP address, otherwise the nodes are only reachable through the network of the cluster. Private and public node pools can be mixed in a cluster",
 		},
 		"labels": {
 			Type:     schema.TypeMap,
@@ -48,10 +48,12 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
+			Description: "Kubernetes labels applied to the nodes of the pool",
 		},
 		"taint": {
-			Type:     schema.TypeSet,
-			Optional: true,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Kubernetes taints applied to the nodes of the pool",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"key": {
@@ -77,6 +79,9 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 	}
 
 	if isResource {
+		// add the number of GPUs of the size to the schema
+		s["gpu_count"] = utils.GPUCountSchema()
+
 		// add the cluster id to the schema
 		s["cluster_id"] = &schema.Schema{
 			Type:         schema.TypeString,
@@ -84,28 +89,61 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Description:  "The ID of your cluster",
 			ValidateFunc: validation.StringIsNotEmpty,
 		}
+
+		// the API can't change whether the nodes of an existing pool have public IPs
+		s["public_ip_node_pool"].ForceNew = true
+
+		// add how the pool is replaced when its size or label changes
+		s["replace_strategy"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      replaceStrategyRolling,
+			ValidateFunc: validation.StringInSlice([]string{replaceStrategyRolling, replaceStrategyRecreate}, false),
+			Description: "How the pool is replaced when its `size` or `label` changes, as the API can't change them: `rolling` creates the new pool and deletes the old one once the new nodes are active, " +
+				"the label has to change too when it's set; `recreate` destroys the pool before creating the new one. The default is `rolling`",
+		}
+
+		// add the region to the schema
+		s["region"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The region of the cluster that holds the node pool, if not declared we use the region declared in the provider",
+		}
 	}
 
 	return s
 }
 
 // function to flatten all instances inside the cluster
-func flattenNodePool(cluster *civogo.KubernetesCluster) []interface{} {
+func flattenNodePool(cluster *civogo.KubernetesCluster, label string) []interface{} {
 	if cluster.Pools == nil {
 		return nil
 	}
 
+	// the pool of the cluster is the one with the label in the state, or the first one
+	pool := cluster.Pools[0]
+	for _, p := range cluster.Pools {
+		if label != "" && p.ID == label {
+			pool = p
+			break
+		}
+	}
+
 	flattenedPool := make([]interface{}, 0)
 
 	poolInstanceNames := make([]string, 0)
-	poolInstanceNames = append(poolInstanceNames, cluster.Pools[0].InstanceNames...)
+	poolInstanceNames = append(poolInstanceNames, pool.InstanceNames...)
 
 	rawPool := map[string]interface{}{
-		"label":               cluster.Pools[0].ID,
-		"node_count":          cluster.Pools[0].Count,
-		"size":                cluster.Pools[0].Size,
+		"label":               pool.ID,
+		"node_count":          pool.Count,
+		"size":                pool.Size,
 		"instance_names":      poolInstanceNames,
-		"public_ip_node_pool": cluster.Pools[0].PublicIPNodePool,
+		"public_ip_node_pool": pool.PublicIPNodePool,
+		"labels":              pool.Labels,
+		"taint":               flattenTaints(pool.Taints),
 	}
 
 	flattenedPool = append(flattenedPool, rawPool)
@@ -113,6 +151,35 @@ func flattenNodePool(cluster *civogo.KubernetesCluster) []interface{} {
 	return flattenedPool
 }
 
+// flattenTaints function to flatten the taints of a node pool
+func flattenTaints(taints []corev1.Taint) []interface{} {
+	flattenedTaints := make([]interface{}, 0, len(taints))
+	for _, taint := range taints {
+		flattenedTaints = append(flattenedTaints, map[string]interface{}{
+			"key":    taint.Key,
+			"value":  taint.Value,
+			"effect": string(taint.Effect),
+		})
+	}
+
+	return flattenedTaints
+}
+
+// expandTaints function to expand the taints of a node pool
+func expandTaints(taintSet *schema.Set) []corev1.Taint {
+	taints := []corev1.Taint{}
+	for _, v := range taintSet.List() {
+		taint := v.(map[string]interface{})
+		taints = append(taints, corev1.Taint{
+			Key:    taint["key"].(string),
+			Value:  taint["value"].(string),
+			Effect: corev1.TaintEffect(taint["effect"].(string)),
+		})
+	}
+
+	return taints
+}
+
 // function to flatten all applications inside the cluster
 func flattenInstalledApplication(apps []civogo.KubernetesInstalledApplication) []interface{} {
 	if apps == nil {
@@ -159,14 +226,7 @@ func expandNodePools(nodePools []interface{}) []civogo.KubernetesClusterPoolConf
 		// Initialize taints slice only if they are provided and valid
 		var taints []corev1.Taint
 		if taintSet, ok := pool["taint"].(*schema.Set); ok {
-			for _, taintInterface := range taintSet.List() {
-				taintMap := taintInterface.(map[string]interface{})
-				taints = append(taints, corev1.Taint{
-					Key:    taintMap["key"].(string),
-					Value:  taintMap["value"].(string),
-					Effect: corev1.TaintEffect(taintMap["effect"].(string)),
-				})
-			}
+			taints = expandTaints(taintSet)
 		}
 
 		cr := civogo.KubernetesClusterPoolConfig{
@@ -186,3 +246,68 @@ func expandNodePools(nodePools []interface{}) []civogo.KubernetesClusterPoolConf
 
 	return expandedNodePools
 }
+
+// conditionsSchema function to define the schema of the conditions of the control plane of a cluster
+func conditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The conditions of the control plane of the cluster, to gate on the health of the cluster",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the condition",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status of the condition, one of `True`, `False` or `Unknown`",
+				},
+				"synced": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "If the condition is synced, this will return `true`",
+				},
+				"reason": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The reason of the last transition of the condition",
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "A message with details about the last transition of the condition",
+				},
+				"last_transition_time": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The timestamp of the last transition of the condition",
+				},
+			},
+		},
+	}
+}
+
+// flattenConditions function to flatten the conditions of the control plane of a cluster
+func flattenConditions(conditions []civogo.Condition) []interface{} {
+	flattenedConditions := make([]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		lastTransitionTime := ""
+		if !condition.LastTransitionTime.IsZero() {
+			lastTransitionTime = condition.LastTransitionTime.UTC().String()
+		}
+
+		flattenedConditions = append(flattenedConditions, map[string]interface{}{
+			"type":                 condition.Type,
+			"status":               string(condition.Status),
+			"synced":               condition.Synced,
+			"reason":               condition.Reason,
+			"message":              condition.Message,
+			"last_transition_time": lastTransitionTime,
+		})
+	}
+
+	return flattenedConditions
+}
@@ -0,0 +1,126 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// marketplaceApplications returns the applications of the marketplace, cached for the provider
+func marketplaceApplications(m interface{}, apiClient *civogo.Client) ([]civogo.KubernetesMarketplaceApplication, error) {
+	apps, err := utils.Cached(m, "kubernetes_applications/"+apiClient.Region, apiClient.ListKubernetesMarketplaceApplications)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the marketplace applications: %s", err)
+	}
+
+	return apps, nil
+}
+
+// findMarketplaceApplication checks the application and its plan are available in the marketplace, the name
+// of the application has to match exactly. When it doesn't, the error lists the available applications or plans.
+func findMarketplaceApplication(apps []civogo.KubernetesMarketplaceApplication, name, plan string) error {
+	names := make([]string, 0, len(apps))
+	for _, app := range apps {
+		names = append(names, app.Name)
+		if app.Name != name {
+			continue
+		}
+		if plan == "" {
+			return nil
+		}
+
+		plans := []string{}
+		for _, p := range app.Plans {
+			// the spaces of the plans are removed when the applications of a cluster are installed
+			if p.Label == plan || strings.ReplaceAll(p.Label, " ", "") == strings.ReplaceAll(plan, " ", "") {
+				return nil
+			}
+			plans = append(plans, p.Label)
+		}
+		return fmt.Errorf("[ERR] the plan %q is not available for the application %s, the available plans are: %s", plan, app.Name, strings.Join(plans, ", "))
+	}
+
+	for _, app := range apps {
+		if strings.EqualFold(app.Name, name) {
+			return fmt.Errorf("[ERR] the application %s is not available in the marketplace, application names are case-sensitive, did you mean %s?", name, app.Name)
+		}
+	}
+
+	sort.Strings(names)
+	return fmt.Errorf("[ERR] the application %s is not available in the marketplace, the available applications are: %s", name, strings.Join(names, ", "))
+}
+
+// checkMarketplaceApplications checks every application of the comma separated list of applications of a
+// cluster is available in the marketplace, including the default applications removed with a `-` prefix
+func checkMarketplaceApplications(apps []civogo.KubernetesMarketplaceApplication, applications string) error {
+	for _, application := range strings.Split(applications, ",") {
+		application = strings.TrimPrefix(strings.TrimSpace(application), "-")
+		if application == "" {
+			continue
+		}
+
+		name, plan, _ := strings.Cut(application, ":")
+		if err := findMarketplaceApplication(apps, strings.TrimSpace(name), strings.TrimSpace(plan)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applicationsCustomizeDiff refuses to plan the applications of a cluster that aren't available in the
+// marketplace. The check is skipped when the marketplace can't be listed, the create checks them again.
+func applicationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("applications") || d.Get("applications").(string) == "" || (d.Id() != "" && !d.HasChange("applications")) {
+		return nil
+	}
+
+	meta, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	apps, err := marketplaceApplications(meta, apiClient)
+	if err != nil {
+		tflog.Warn(ctx, "unable to list the marketplace applications to validate the applications of the cluster", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	return checkMarketplaceApplications(apps, d.Get("applications").(string))
+}
+
+// applicationCustomizeDiff refuses to plan a `civo_kubernetes_application` that isn't available in the marketplace
+func applicationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("name") {
+		return nil
+	}
+
+	meta, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	apps, err := marketplaceApplications(meta, apiClient)
+	if err != nil {
+		tflog.Warn(ctx, "unable to list the marketplace applications to validate the application", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	// the plan is unknown when it isn't configured, as it's read from the installed application
+	plan := ""
+	if d.NewValueKnown("plan") {
+		plan = d.Get("plan").(string)
+	}
+
+	return findMarketplaceApplication(apps, d.Get("name").(string), plan)
+}
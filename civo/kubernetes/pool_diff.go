@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// poolSizeCustomizeDiff returns a CustomizeDiffFunc checking the size of the node pool whose attributes
+// start with prefix is a Kubernetes size, as the instance and database sizes can't be used for nodes. Only new
+// sizes are checked, so the pools on a size Civo has retired can still be planned.
+func poolSizeCustomizeDiff(prefix string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if d.Id() != "" && !d.HasChange(prefix+"size") {
+			return nil
+		}
+		if !d.NewValueKnown(prefix+"size") || !d.NewValueKnown("region") {
+			return nil
+		}
+
+		meta, apiClient, err := utils.DiffClient(m, d)
+		if err != nil {
+			return err
+		}
+
+		name := d.Get(prefix + "size").(string)
+		size, err := utils.FindSize(meta, apiClient, name)
+		if err != nil || size == nil {
+			// an unavailable size is reported by the size checks, or left to the Civo API
+			return nil
+		}
+
+		if size.Type != "" && !strings.EqualFold(size.Type, "kubernetes") {
+			return fmt.Errorf("[ERR] the size %q is a %s size, the nodes of a node pool need a Kubernetes size (e.g. g4s.kube.medium)", name, size.Type)
+		}
+
+		return nil
+	}
+}
+
+// poolRegionCustomizeDiff checks when planning a new node pool that its cluster is in the region of the
+// node pool, the check is skipped when the cluster isn't created yet or can't be read
+func poolRegionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("cluster_id") || !d.NewValueKnown("region") {
+		return nil
+	}
+
+	_, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if _, err := apiClient.GetKubernetesCluster(clusterID); err != nil {
+		if utils.IsNotFound(err) {
+			return fmt.Errorf("[ERR] the kubernetes cluster %s doesn't exist in the region %s, a node pool must be in the region of its cluster", clusterID, apiClient.Region)
+		}
+		tflog.Warn(ctx, "unable to read the kubernetes cluster to validate the region of the node pool", map[string]interface{}{
+			"cluster_id": clusterID,
+			"error":      err.Error(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// replaceStrategyRolling creates the new pool before deleting the old one
+	replaceStrategyRolling = "rolling"
+	// replaceStrategyRecreate lets Terraform destroy the pool before creating the new one
+	replaceStrategyRecreate = "recreate"
+)
+
+// replaceStrategyCustomizeDiff replaces the node pool resource when its size or label changes and its
+// `replace_strategy` is `recreate`, otherwise the pool is replaced by the update
+func replaceStrategyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.Get("replace_strategy").(string) != replaceStrategyRecreate {
+		return poolLabelCustomizeDiff("")(ctx, d, m)
+	}
+
+	for _, key := range []string{"size", "label"} {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// createReplacementPool creates the pool replacing a pool of the cluster, as the API can't change the size or
+// the label of a pool, and waits until its nodes are active. The new pool is deleted again if its nodes don't
+// become active, so it isn't left behind when the replacement fails. Once the old pool is deleted with
+// deleteReplacedPool the workloads are rescheduled on the new nodes.
+func createReplacementPool(ctx context.Context, apiClient *civogo.Client, clusterID string, newPool *civogo.KubernetesClusterPoolUpdateConfig, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("creating the node pool %s of the kubernetes cluster %s to replace a node pool", newPool.ID, clusterID))
+	if _, err := apiClient.CreateKubernetesClusterPool(clusterID, newPool); err != nil {
+		return fmt.Errorf("[ERR] failed to create the node pool %s of the kubernetes cluster %s: %s", newPool.ID, clusterID, err)
+	}
+
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetKubernetesCluster(clusterID)
+			if err != nil {
+				return 0, "", err
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("creating the node pool %s, active nodes per pool: %s", newPool.ID, poolUpgradeProgress(resp)))
+			for _, pool := range resp.Pools {
+				if pool.ID == newPool.ID && len(pool.Instances) > 0 && poolReady(pool) {
+					return resp, "ACTIVE", nil
+				}
+			}
+			return resp, "CREATING", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := createStateConf.WaitForStateContext(ctx); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("deleting the node pool %s of the kubernetes cluster %s as its nodes aren't active", newPool.ID, clusterID))
+		if _, deleteErr := apiClient.DeleteKubernetesClusterPool(clusterID, newPool.ID); deleteErr != nil && !utils.IsNotFound(deleteErr) {
+			return fmt.Errorf("error waiting for the node pool %s of the kubernetes cluster %s to be created: %s, and the node pool couldn't be deleted, delete it manually: %s", newPool.ID, clusterID, err, deleteErr)
+		}
+		return fmt.Errorf("error waiting for the node pool %s of the kubernetes cluster %s to be created, the node pool was deleted: %s", newPool.ID, clusterID, err)
+	}
+
+	return nil
+}
+
+// deleteReplacedPool deletes the pool oldID of the cluster once the pool replacing it is active and waits until
+// it's deleted
+func deleteReplacedPool(ctx context.Context, apiClient *civogo.Client, clusterID, oldID string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("deleting the node pool %s of the kubernetes cluster %s", oldID, clusterID))
+	if _, err := apiClient.DeleteKubernetesClusterPool(clusterID, oldID); err != nil && !utils.IsNotFound(err) {
+		return fmt.Errorf("[ERR] failed to delete the node pool %s of the kubernetes cluster %s: %s", oldID, clusterID, err)
+	}
+
+	deleteStateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			_, err := apiClient.GetKubernetesClusterPool(clusterID, oldID)
+			if err != nil {
+				if utils.IsNotFound(err) {
+					return oldID, "DELETED", nil
+				}
+				return 0, "", err
+			}
+			return oldID, "DELETING", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the node pool %s of the kubernetes cluster %s to be deleted: %s", oldID, clusterID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceKubernetesApplication function returns a schema.Resource that represents a marketplace application installed in a Kubernetes cluster.
+// This can be used to install applications after the cluster is created, instead of adding them to the `applications` of the cluster.
+func ResourceKubernetesApplication() *schema.Resource {
+	return &schema.Resource{
+		Description: strings.Join([]string{
+			"Provides a Civo Kubernetes marketplace application resource. This can be used to install an application in an existing cluster.",
+			"Note: The Civo API can't uninstall marketplace applications, destroying the resource only removes it from the state and the application is left installed in the cluster.",
+		}, "\n\n"),
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the cluster to install the application in",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The name of the marketplace application, the available applications can be listed with the Civo CLI: 'civo kubernetes applications ls'",
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The plan of the application, for the applications that have plans (e.g. `5GB` for MariaDB)",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the cluster, if not declared we use the region declared in the provider",
+			},
+			// computed attributes
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the installed application",
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The category of the application",
+			},
+			"installed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Application installation status (`true` if installed)",
+			},
+			"configuration": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The configuration values of the installed application and its plan",
+			},
+		},
+		CreateContext: resourceKubernetesApplicationCreate,
+		ReadContext:   resourceKubernetesApplicationRead,
+		DeleteContext: resourceKubernetesApplicationDelete,
+		CustomizeDiff: applicationCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKubernetesApplicationImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+// findInstalledApplication returns the application with the given name installed in the cluster, or nil if it isn't installed
+func findInstalledApplication(cluster *civogo.KubernetesCluster, name string) *civogo.KubernetesInstalledApplication {
+	for i, app := range cluster.InstalledApplications {
+		if strings.EqualFold(app.Name, name) || strings.EqualFold(app.Application, name) {
+			return &cluster.InstalledApplications[i]
+		}
+	}
+
+	return nil
+}
+
+// function to install the application in the cluster
+func resourceKubernetesApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	plan := d.Get("plan").(string)
+
+	apps, err := marketplaceApplications(m, apiClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := findMarketplaceApplication(apps, name, plan); err != nil {
+		return diag.FromErr(err)
+	}
+
+	application := name
+	if plan != "" {
+		application = fmt.Sprintf("%s:%s", name, plan)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("installing the application %s in the kubernetes cluster %s", application, clusterID))
+	_, err = apiClient.UpdateKubernetesCluster(clusterID, &civogo.KubernetesClusterConfig{
+		Applications: application,
+		Region:       apiClient.Region,
+	})
+	if err != nil {
+		return diag.Errorf("[ERR] failed to install the application %s in the kubernetes cluster %s: %s", application, clusterID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", clusterID, name))
+
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		cluster, err := apiClient.GetKubernetesCluster(clusterID)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if app := findInstalledApplication(cluster, name); app == nil || !app.Installed {
+			return retry.RetryableError(fmt.Errorf("the application %s is not installed yet", name))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error waiting for the application %s to be installed in the kubernetes cluster %s: %s", name, clusterID, err)
+	}
+
+	return resourceKubernetesApplicationRead(ctx, d, m)
+}
+
+// function to read the application installed in the cluster
+func resourceKubernetesApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("retrieving the application %s of the kubernetes cluster %s", name, clusterID))
+	cluster, err := apiClient.GetKubernetesCluster(clusterID)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "kubernetes application")
+		}
+		return diag.Errorf("[ERR] failed to find the kubernetes cluster: %s", err)
+	}
+
+	app := findInstalledApplication(cluster, name)
+	if app == nil {
+		return utils.RemoveFromState(ctx, d, "kubernetes application")
+	}
+
+	configuration := map[string]string{}
+	for key, value := range app.Configuration {
+		configuration[key] = value["value"]
+	}
+
+	d.Set("region", apiClient.Region)
+	d.Set("version", app.Version)
+	d.Set("category", app.Category)
+	d.Set("installed", app.Installed)
+	d.Set("configuration", configuration)
+	if d.Get("plan").(string) == "" {
+		// only read the plan when it isn't configured, e.g. when importing, as the API may return it differently
+		d.Set("plan", app.Plan)
+	}
+
+	return nil
+}
+
+// function to remove the application from the state, the API can't uninstall it
+func resourceKubernetesApplicationDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	tflog.Warn(ctx, fmt.Sprintf("the application %s can't be uninstalled from the kubernetes cluster %s, removing it from the state", name, clusterID))
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "The marketplace application was left installed",
+			Detail:   fmt.Sprintf("The Civo API can't uninstall marketplace applications, the application %s was removed from the state but it's still installed in the kubernetes cluster %s.", name, clusterID),
+		},
+	}
+}
+
+// function to import an application installed in a cluster using `cluster_id:name` or `cluster_name:name`
+func resourceKubernetesApplicationImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clusterRef, name, err := utils.ResourceCommonParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID, err := resolveKubernetesClusterID(utils.ResourceClient(m, d), clusterRef)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", clusterID, name))
+	d.Set("cluster_id", clusterID)
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,50 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCivoKubernetesApplication_basic(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	resName := "civo_kubernetes_cluster.foobar"
+	resAppName := "civo_kubernetes_application.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigBasic(kubernetesClusterName) + CivoKubernetesApplicationConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					resource.TestCheckResourceAttrPair(resAppName, "cluster_id", resName, "id"),
+					resource.TestCheckResourceAttr(resAppName, "name", "metrics-server"),
+					resource.TestCheckResourceAttr(resAppName, "installed", "true"),
+					resource.TestCheckResourceAttrSet(resAppName, "version"),
+				),
+			},
+			{
+				ResourceName:      resAppName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func CivoKubernetesApplicationConfigBasic() string {
+	return `
+resource "civo_kubernetes_application" "foobar" {
+	cluster_id = civo_kubernetes_cluster.foobar.id
+	name = "metrics-server"
+}
+`
+}
@@ -2,14 +2,15 @@ package kubernetes
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -37,7 +38,7 @@ func ResourceKubernetesCluster() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The network for the cluster, if not declare we use the default one",
+				Description: "The network for the cluster, if not declare we use the default one. The CIDR of the network can't overlap the pod and service CIDRs of the cluster (`10.42.0.0/16` and `10.43.0.0/16` for k3s, `10.244.0.0/16` and `10.96.0.0/12` for talos)",
 			},
 			"num_target_nodes": {
 				Type:         schema.TypeInt,
@@ -58,28 +59,31 @@ func ResourceKubernetesCluster() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The version of k3s to install (optional, the default is currently the latest available)",
+				Description: "The version of kubernetes to install, it must be one of the versions of the `cluster_type` (optional, the default is currently the latest available for the type). Changing it upgrades the cluster in place, clusters can't be downgraded",
 			},
 			"cni": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				Description:  "The cni for the k3s to install (the default is `flannel`) valid options are `cilium` or `flannel`",
+				Description:  "The cni for the cluster to install (the default is `flannel`), for example `cilium` or `flannel`. Other plugins supported by the Civo API can be used too, talos clusters only support `flannel`",
 				ValidateFunc: utils.ValidateCNIName,
 			},
 			"tags": {
-				Type:        schema.TypeString,
+				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "Space separated list of tags, to be used freely as required",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A set of tags, to be used freely as required. The tags are also set on the instances of the nodes of the cluster",
 			},
+			"tags_all":            utils.TagsAllSchema(),
+			"deletion_protection": utils.DeletionProtectionSchema(),
 			"applications": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: strings.Join([]string{
 					"Comma separated list of applications to install.",
 					"Spaces within application names are fine, but shouldn't be either side of the comma.",
-					"Application names are case-sensitive; the available applications can be listed with the Civo CLI:",
-					"'civo kubernetes applications ls'.",
+					"Application names are case-sensitive; the available applications can be listed with the `civo_kubernetes_marketplace_applications` data source",
+					"or the Civo CLI: 'civo kubernetes applications ls'. The applications are checked against the marketplace when planning.",
 					"If you want to remove a default installed application, prefix it with a '-', e.g. -Traefik.",
 					"For application that supports plans, you can use 'app_name:app_plan' format e.g. 'Linkerd:Linkerd & Jaeger' or 'MariaDB:5GB'.",
 				}, " "),
@@ -87,23 +91,53 @@ func ResourceKubernetesCluster() *schema.Resource {
 			"firewall_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The existing firewall ID to use for this cluster",
+				Description: "The existing firewall ID to use for this cluster, it has to be in the network of the cluster. Changing it moves the nodes of the cluster to the new firewall without recreating the cluster",
+			},
+			"enable_autoscaler": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the `civo-cluster-autoscaler` marketplace application is installed so the node pools with `min_nodes` and `max_nodes` are autoscaled. " +
+					"The application isn't uninstalled by the provider once it's enabled",
+			},
+			"api_access_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "The CIDRs allowed to reach the Kubernetes API server (port 6443). The ingress rules of the `firewall_id` for the port are replaced with a single rule for these CIDRs, " +
+					"removing them opens the API server to everyone again." +
+					" Don't set it when the firewall is a `civo_firewall` with `ingress_rule` blocks, as that resource reconciles the same rules and the two would keep changing them, declare the rule for the port in the `civo_firewall` instead",
+			},
+			"wait_for": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      waitForInstances,
+				ValidateFunc: validation.StringInSlice([]string{waitForInstances, waitForControlPlane, waitForNone}, false),
+				Description: "What the creation of the cluster waits for: `instances` waits until every node of the pools is active, `control_plane` until the API server can be reached " +
+					"and `none` doesn't wait. The default is `instances`",
 			},
 			"cluster_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The type of cluster to create, valid options are `k3s` or `talos` the default is `k3s`",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{clusterTypeK3s, clusterTypeTalos}, false),
+				Description: "The type of cluster to create, valid options are `k3s` or `talos` the default is `k3s`. " +
+					"Talos clusters don't support marketplace applications (`applications` and `enable_autoscaler`) and only support the `flannel` CNI plugin",
 			},
 			// Computed resource
 			"installed_applications": applicationSchema(),
 			"pools": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The node pool created with the cluster. Changing its `size` or `label` replaces the pool, the new pool is created and the old one is deleted once the nodes of the new pool are active",
 				Elem: &schema.Resource{
-					Schema: nodePoolSchema(false),
+					Schema: autoscalerSchema(nodePoolSchema(false)),
 				},
 			},
 			"status": {
@@ -111,6 +145,8 @@ func ResourceKubernetesCluster() *schema.Resource {
 				Computed:    true,
 				Description: "Status of the cluster",
 			},
+			"pool_status": poolStatusSchema(),
+			"conditions":  conditionsSchema(),
 			"ready": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -147,11 +183,32 @@ func ResourceKubernetesCluster() *schema.Resource {
 		ReadContext:   resourceKubernetesClusterRead,
 		UpdateContext: resourceKubernetesClusterUpdate,
 		DeleteContext: resourceKubernetesClusterDelete,
+		CustomizeDiff: customdiff.All(
+			utils.TagsAllCustomizeDiff(func(d *schema.ResourceDiff) []string {
+				return utils.SetToStrings(d.Get("tags").(*schema.Set))
+			}),
+			poolSizeCustomizeDiff("pools.0."),
+			poolLabelCustomizeDiff("pools.0.", "pools"),
+			autoscalerCustomizeDiff("pools.0."),
+			versionCustomizeDiff,
+			networkCIDRCustomizeDiff,
+			clusterTypeCustomizeDiff,
+			applicationsCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceKubernetesClusterV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKubernetesClusterStateUpgradeV0,
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
@@ -192,14 +249,9 @@ func applicationSchema() *schema.Schema {
 
 // function to create a new cluster
 func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] configuring a new kubernetes cluster %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("configuring a new kubernetes cluster %s", d.Get("name").(string)))
 
 	config := &civogo.KubernetesClusterConfig{
 		Region:      apiClient.Region,
@@ -226,26 +278,29 @@ func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData
 		config.KubernetesVersion = attr.(string)
 	}
 
-	if attr, ok := d.GetOk("tags"); ok {
-		config.Tags = attr.(string)
-	} else {
-		config.Tags = ""
-	}
+	config.Tags = strings.Join(utils.MergeDefaultTags(m, utils.SetToStrings(d.Get("tags").(*schema.Set))), " ")
 
 	if attr, ok := d.GetOk("cni"); ok {
 		config.CNIPlugin = attr.(string)
 	}
 
 	if attr, ok := d.GetOk("applications"); ok {
-		if utils.CheckAPPName(attr.(string), apiClient) {
-			config.Applications = strings.ReplaceAll(attr.(string), " ", "")
-		} else {
-			return diag.Errorf("[ERR] the app that tries to install is not valid: %s", attr.(string))
+		apps, err := marketplaceApplications(m, apiClient)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		if err := checkMarketplaceApplications(apps, attr.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+		config.Applications = strings.ReplaceAll(attr.(string), " ", "")
 	} else {
 		config.Applications = ""
 	}
 
+	if d.Get("enable_autoscaler").(bool) {
+		config.Applications = withAutoscalerApplication(config.Applications)
+	}
+
 	if attr, ok := d.GetOk("cluster_type"); ok {
 		config.ClusterType = attr.(string)
 	}
@@ -267,8 +322,7 @@ func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData
 	pools := expandNodePools(d.Get("pools").([]interface{}))
 	config.Pools = pools
 
-	log.Printf("[INFO] creating a new kubernetes cluster %s", d.Get("name").(string))
-	log.Printf("[INFO] kubernertes config %+v", config)
+	tflog.Info(ctx, fmt.Sprintf("creating a new kubernetes cluster %s", d.Get("name").(string)))
 	resp, err := apiClient.NewKubernetesClusters(config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create the kubernetes cluster: %s", err)
@@ -276,44 +330,32 @@ func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(resp.ID)
 
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{"BUILDING", "AVAILABLE", "UPGRADING", "SCALING"},
-		Target:  []string{"ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := apiClient.GetKubernetesCluster(d.Id())
-			if err != nil {
-				return 0, "", err
-			}
-			return resp, resp.Status, nil
-		},
-		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 10,
+	if err := waitForKubernetesCluster(ctx, apiClient, d.Id(), d.Get("wait_for").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
 	}
-	_, err = createStateConf.WaitForStateContext(context.Background())
-	if err != nil {
-		return diag.Errorf("error waiting for cluster (%s) to be created: %s", d.Id(), err)
+
+	if cidrs := utils.SetToStrings(d.Get("api_access_cidrs").(*schema.Set)); len(cidrs) > 0 {
+		if err := setAPIAccessCIDRs(ctx, apiClient, config.InstanceFirewall, cidrs); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := propagateTagsToNodes(ctx, apiClient, d.Id(), nil, strings.Fields(config.Tags)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceKubernetesClusterRead(ctx, d, m)
 }
 
 // function to read the kubernetes cluster
-func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retrieving the kubernetes cluster %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retrieving the kubernetes cluster %s", d.Id()))
 	resp, err := apiClient.GetKubernetesCluster(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "kubernetes cluster")
 		}
 		return diag.Errorf("[ERR] failed to find the kubernetes cluster: %s", err)
 	}
@@ -326,8 +368,15 @@ func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m
 	d.Set("kubernetes_version", resp.KubernetesVersion)
 	d.Set("cluster_type", resp.ClusterType)
 	d.Set("cni", resp.CNIPlugin)
-	d.Set("tags", strings.Join(resp.Tags, " ")) // space separated tags
+	d.Set("tags", utils.RemoveDefaultTags(m, resp.Tags, utils.SetToStrings(d.Get("tags").(*schema.Set))))
+	d.Set("tags_all", resp.Tags)
 	d.Set("status", resp.Status)
+	if err := d.Set("pool_status", flattenPoolStatus(resp)); err != nil {
+		return diag.Errorf("[ERR] error retrieving the status of the pools for kubernetes cluster error: %#v", err)
+	}
+	if err := d.Set("conditions", flattenConditions(resp.Conditions)); err != nil {
+		return diag.Errorf("[ERR] error retrieving the conditions for kubernetes cluster error: %#v", err)
+	}
 	d.Set("ready", resp.Ready)
 	d.Set("kubeconfig", resp.KubeConfig)
 	d.Set("api_endpoint", resp.APIEndPoint)
@@ -337,7 +386,23 @@ func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m
 	d.Set("created_at", resp.CreatedAt.UTC().String())
 	d.Set("firewall_id", resp.FirewallID)
 
-	if err := d.Set("pools", flattenNodePool(resp)); err != nil {
+	cidrs, managed, err := apiAccessCIDRs(apiClient, resp.FirewallID)
+	if err != nil && !utils.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	if !managed {
+		cidrs = []string{}
+	}
+	d.Set("api_access_cidrs", cidrs)
+
+	pools := flattenNodePool(resp, d.Get("pools.0.label").(string))
+	if len(pools) > 0 {
+		// the autoscaler bounds are only known by the configuration
+		pool := pools[0].(map[string]interface{})
+		pool["min_nodes"] = d.Get("pools.0.min_nodes").(int)
+		pool["max_nodes"] = d.Get("pools.0.max_nodes").(int)
+	}
+	if err := d.Set("pools", pools); err != nil {
 		return diag.Errorf("[ERR] error retrieving the pool for kubernetes cluster error: %#v", err)
 	}
 
@@ -350,12 +415,7 @@ func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m
 
 // function to update the kubernetes cluster
 func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	config := &civogo.KubernetesClusterConfig{}
 
@@ -364,22 +424,42 @@ func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if d.HasChange("firewall_id") {
-		return diag.Errorf("[ERR] Firewall change (%q) for existing cluster is not available at this moment", "firewall_id")
+		if err := setClusterFirewall(ctx, apiClient, d.Id(), d.Get("network_id").(string), d.Get("firewall_id").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// the API server of the cluster is only reachable from the CIDRs in the new firewall too
+	apiAccessCIDRs := utils.SetToStrings(d.Get("api_access_cidrs").(*schema.Set))
+	if d.HasChange("api_access_cidrs") || (d.HasChange("firewall_id") && len(apiAccessCIDRs) > 0) {
+		if err := setAPIAccessCIDRs(ctx, apiClient, d.Get("firewall_id").(string), apiAccessCIDRs); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	// Update the node pool if necessary
-	if !d.HasChange("pools") {
+	if d.HasChange("kubernetes_version") {
+		if err := upgradeKubernetesCluster(ctx, apiClient, d.Id(), d.Get("kubernetes_version").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Update the node pool or the tags if necessary
+	if !d.HasChanges("pools", "tags", "tags_all", "enable_autoscaler") {
 		return resourceKubernetesClusterRead(ctx, d, m)
 	}
 
+	// the pool of the cluster that is changed, the update waits for its nodes
+	targetNodePool := ""
 	if d.HasChange("pools") {
 		old, new := d.GetChange("pools")
 		oldPool := old.([]interface{})[0].(map[string]interface{})
 		newPool := new.([]interface{})[0].(map[string]interface{})
 
-		// if the size is different, then return and error as we can't change the size of a pool
-		if oldPool["size"].(string) != newPool["size"].(string) {
-			return diag.Errorf("[ERR] Size change (%q) for existing cluster is not available at this moment", "size")
+		// the size and the label of a pool can't be changed, the pool is replaced instead
+		if oldPool["size"].(string) != newPool["size"].(string) || oldPool["label"].(string) != newPool["label"].(string) {
+			if err := replaceDefaultNodePool(ctx, apiClient, d, oldPool, newPool); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
 		config.Region = apiClient.Region
@@ -388,7 +468,6 @@ func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData
 			return diag.Errorf("[ERR] failed to find the kubernetes cluster: %s", err)
 		}
 
-		targetNodePool := ""
 		nodePools := []civogo.KubernetesClusterPoolConfig{}
 		for _, v := range kubernetesCluster.Pools {
 			nodePools = append(nodePools, civogo.KubernetesClusterPoolConfig{ID: v.ID, Count: v.Count, Size: v.Size, Labels: v.Labels, Taints: v.Taints})
@@ -398,13 +477,20 @@ func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		nodePools = updateNodePool(nodePools, targetNodePool, newPool["node_count"].(int))
-		config.Pools = nodePools
-	}
 
-	if d.HasChange("kubernetes_version") {
-		// config.KubernetesVersion = d.Get("kubernetes_version").(string)
-		// config.Region = apiClient.Region
-		return diag.Errorf("[ERR] Kubernetes version upgrade (%q attribute) is not supported yet", "kubernetes_version")
+		// apply the labels and taints of the pool, so they don't have to be set with kubectl
+		for i := range nodePools {
+			if nodePools[i].ID != targetNodePool {
+				continue
+			}
+			labels := map[string]string{}
+			for k, v := range newPool["labels"].(map[string]interface{}) {
+				labels[k] = v.(string)
+			}
+			nodePools[i].Labels = labels
+			nodePools[i].Taints = expandTaints(newPool["taint"].(*schema.Set))
+		}
+		config.Pools = nodePools
 	}
 
 	if d.HasChange("applications") {
@@ -412,39 +498,59 @@ func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData
 		config.Region = apiClient.Region
 	}
 
+	if d.HasChange("enable_autoscaler") {
+		if !d.Get("enable_autoscaler").(bool) {
+			return diag.Errorf("[ERR] the cluster autoscaler can't be disabled once it's enabled, uninstall the %s application from the cluster instead", autoscalerApplication)
+		}
+		config.Applications = withAutoscalerApplication(config.Applications)
+		config.Region = apiClient.Region
+	}
+
 	if d.HasChange("name") {
 		config.Name = d.Get("name").(string)
 		config.Region = apiClient.Region
 	}
 
-	if d.HasChange("tags") {
-		config.Tags = d.Get("tags").(string)
+	// the tags are needed apart from their changes, the nodes added to the pool are tagged with them
+	tags := utils.MergeDefaultTags(m, utils.SetToStrings(d.Get("tags").(*schema.Set)))
+	if d.HasChanges("tags", "tags_all") {
+		config.Tags = strings.Join(tags, " ")
+		config.Region = apiClient.Region
 	}
 
-	log.Printf("[INFO] updating the kubernetes cluster %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("updating the kubernetes cluster %s", d.Id()))
 	_, err := apiClient.UpdateKubernetesCluster(d.Id(), config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to update kubernetes cluster: %s", err)
 	}
 
-	err = waitForKubernetesNodePoolCreate(apiClient, d, d.Id())
-	if err != nil {
-		return diag.Errorf("Error updating Kubernetes node pool: %s", err)
+	if targetNodePool != "" {
+		err = waitForKubernetesNodePoolCreate(ctx, apiClient, d.Id(), targetNodePool, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("Error updating Kubernetes node pool: %s", err)
+		}
+	}
+
+	// the tags are also set on the nodes added to the pool
+	if d.HasChanges("tags", "tags_all", "pools") {
+		old, _ := d.GetChange("tags_all")
+		if err := propagateTagsToNodes(ctx, apiClient, d.Id(), utils.SetToStrings(old.(*schema.Set)), tags); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceKubernetesClusterRead(ctx, d, m)
 }
 
 // function to delete the kubernetes cluster
-func resourceKubernetesClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+func resourceKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := utils.CheckDeletionProtection(d, "kubernetes cluster"); diags != nil {
+		return diags
 	}
 
-	log.Printf("[INFO] deleting the kubernetes cluster %s", d.Id())
+	apiClient := utils.ResourceClient(m, d)
+
+	tflog.Info(ctx, fmt.Sprintf("deleting the kubernetes cluster %s", d.Id()))
 	_, err := apiClient.DeleteKubernetesCluster(d.Id())
 	if err != nil {
 		return diag.Errorf("[INFO] an error occurred while trying to delete the kubernetes cluster %s", err)
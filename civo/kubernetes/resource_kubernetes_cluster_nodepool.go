@@ -4,16 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/google/uuid"
-	corev1 "k8s.io/api/core/v1"
-
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,13 +22,14 @@ import (
 func ResourceKubernetesClusterNodePool() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Provides a Civo Kubernetes node pool resource. While the default node pool must be defined in the `civo_kubernetes_cluster` resource, this resource can be used to add additional ones to a cluster.",
-		Schema:        nodePoolSchema(true),
+		Schema:        autoscalerSchema(nodePoolSchema(true)),
 		CreateContext: resourceKubernetesClusterNodePoolCreate,
 		ReadContext:   resourceKubernetesClusterNodePoolRead,
 		UpdateContext: resourceKubernetesClusterNodePoolUpdate,
 		DeleteContext: resourceKubernetesClusterNodePoolDelete,
+		CustomizeDiff: customdiff.All(utils.SizeCustomizeDiff, poolSizeCustomizeDiff(""), poolRegionCustomizeDiff, autoscalerCustomizeDiff(""), replaceStrategyCustomizeDiff),
 		Importer: &schema.ResourceImporter{
-			State: resourceKubernetesClusterNodePoolImport,
+			StateContext: resourceKubernetesClusterNodePoolImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -41,19 +41,17 @@ func ResourceKubernetesClusterNodePool() *schema.Resource {
 
 // function to create a new cluster
 func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	clusterID := d.Get("cluster_id").(string)
 
 	// We check if the cluster exists before creating the node pool or made any process
-	log.Printf("[INFO] getting kubernetes cluster %s in the region %s", clusterID, apiClient.Region)
+	tflog.Info(ctx, fmt.Sprintf("getting kubernetes cluster %s in the region %s", clusterID, apiClient.Region))
 	getKubernetesCluster, err := apiClient.GetKubernetesCluster(clusterID)
 	if err != nil {
+		if utils.IsNotFound(err) {
+			return diag.Errorf("[ERR] the kubernetes cluster %s doesn't exist in the region %s, a node pool must be in the region of its cluster", clusterID, apiClient.Region)
+		}
 		return diag.Errorf("[INFO] error getting kubernetes cluster: %s", clusterID)
 	}
 
@@ -83,17 +81,7 @@ func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.Reso
 		}
 	}
 
-	nodePoolTains := []corev1.Taint{}
-	if attr, ok := d.GetOk("taint"); ok {
-		for _, v := range attr.(*schema.Set).List() {
-			taint := v.(map[string]interface{})
-			nodePoolTains = append(nodePoolTains, corev1.Taint{
-				Key:    taint["key"].(string),
-				Value:  taint["value"].(string),
-				Effect: corev1.TaintEffect(taint["effect"].(string)),
-			})
-		}
-	}
+	nodePoolTains := expandTaints(d.Get("taint").(*schema.Set))
 
 	newPool := &civogo.KubernetesClusterPoolUpdateConfig{
 		ID:     nodePoolLabel,
@@ -108,8 +96,8 @@ func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.Reso
 		newPool.PublicIPNodePool = value.(bool)
 	}
 
-	log.Printf("[INFO] configuring kubernetes cluster %s to add pool %s", getKubernetesCluster.ID, nodePoolLabel)
-	log.Printf("[INFO] Creating a new kubernetes cluster pool %s", nodePoolLabel)
+	tflog.Info(ctx, fmt.Sprintf("configuring kubernetes cluster %s to add pool %s", getKubernetesCluster.ID, nodePoolLabel))
+	tflog.Info(ctx, fmt.Sprintf("Creating a new kubernetes cluster pool %s", nodePoolLabel))
 	_, err = apiClient.CreateKubernetesClusterPool(getKubernetesCluster.ID, newPool)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create the kubernetes cluster: %s", err)
@@ -117,7 +105,7 @@ func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.Reso
 
 	d.SetId(nodePoolLabel)
 
-	err = waitForKubernetesNodePoolCreate(apiClient, d, clusterID)
+	err = waitForKubernetesNodePoolCreate(ctx, apiClient, clusterID, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("Error creating Kubernetes node pool: %s", err)
 	}
@@ -126,81 +114,64 @@ func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.Reso
 }
 
 // function to read the kubernetes cluster
-func resourceKubernetesClusterNodePoolRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceKubernetesClusterNodePoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 	clusterID := d.Get("cluster_id").(string)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
-	log.Printf("[INFO] retrieving the kubernetes cluster %s", clusterID)
+	tflog.Info(ctx, fmt.Sprintf("retrieving the kubernetes cluster %s", clusterID))
 	resp, err := apiClient.GetKubernetesCluster(clusterID)
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "kubernetes node pool")
 		}
 		return diag.Errorf("[ERR] failed to find the kubernetes cluster: %s", err)
 	}
 
-	log.Printf("[INFO] retrieving the kubernetes cluster pool %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retrieving the kubernetes cluster pool %s", d.Id()))
 	respPool, err := apiClient.GetKubernetesClusterPool(clusterID, d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "kubernetes node pool")
 		}
 		return diag.Errorf("[ERR] failed to find the kubernetes cluster pool: %s", err)
 	}
 
 	d.SetId(respPool.ID)
 	d.Set("cluster_id", resp.ID)
+	d.Set("region", apiClient.Region)
 	d.Set("node_count", respPool.Count)
 	d.Set("size", respPool.Size)
-
-	if respPool.PublicIPNodePool {
-		d.Set("public_ip_node_pool", respPool.PublicIPNodePool)
+	if size, err := utils.FindSize(m, apiClient, respPool.Size); err == nil && size != nil {
+		d.Set("gpu_count", size.GPUCount)
 	}
 
+	d.Set("public_ip_node_pool", respPool.PublicIPNodePool)
+
 	poolInstanceNames := make([]string, 0)
 	poolInstanceNames = append(poolInstanceNames, respPool.InstanceNames...)
 
 	d.Set("instance_names", poolInstanceNames)
 
-	if len(respPool.Labels) > 0 {
-		d.Set("labels", respPool.Labels)
-	}
-
-	if len(respPool.Taints) > 0 {
-		taints := make([]map[string]interface{}, 0)
-		for _, taint := range respPool.Taints {
-			taints = append(taints, map[string]interface{}{
-				"key":    taint.Key,
-				"value":  taint.Value,
-				"effect": string(taint.Effect),
-			})
-		}
-		d.Set("taint", taints)
-	}
+	d.Set("labels", respPool.Labels)
+	d.Set("taint", flattenTaints(respPool.Taints))
 
 	return diags
 }
 
 // function to update the kubernetes cluster
 func resourceKubernetesClusterNodePoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	clusterID := d.Get("cluster_id").(string)
 
-	old, new := d.GetChange("size")
-	if old != new {
-		return diag.Errorf("[ERR] Size change (%q) for existing pool is not available at this moment", "size")
+	// the size and the label of a pool can't be changed, the pool is replaced instead
+	if d.HasChanges("size", "label") {
+		return resourceKubernetesClusterNodePoolReplace(ctx, d, m)
 	}
 
-	clusterID := d.Get("cluster_id").(string)
 	poolUpdate := &civogo.KubernetesClusterPoolUpdateConfig{
 		Region: apiClient.Region,
 	}
@@ -229,30 +200,16 @@ func resourceKubernetesClusterNodePoolUpdate(ctx context.Context, d *schema.Reso
 	}
 
 	if d.HasChange("taint") {
-		if attr, ok := d.GetOk("taint"); ok {
-			log.Printf("[INFO] dentro de tains")
-			nodePoolTains := []corev1.Taint{}
-			for _, v := range attr.(*schema.Set).List() {
-				taint := v.(map[string]interface{})
-				nodePoolTains = append(nodePoolTains, corev1.Taint{
-					Key:    taint["key"].(string),
-					Value:  taint["value"].(string),
-					Effect: corev1.TaintEffect(taint["effect"].(string)),
-				})
-			}
-			poolUpdate.Taints = nodePoolTains
-		} else {
-			poolUpdate.Taints = []corev1.Taint{}
-		}
+		poolUpdate.Taints = expandTaints(d.Get("taint").(*schema.Set))
 	}
 
-	log.Printf("[INFO] updating the kubernetes cluster pool %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("updating the kubernetes cluster pool %s", d.Id()))
 	_, err = apiClient.UpdateKubernetesClusterPool(getKubernetesCluster.ID, d.Id(), poolUpdate)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to update kubernetes cluster pool: %s", err)
 	}
 
-	err = waitForKubernetesNodePoolCreate(apiClient, d, clusterID)
+	err = waitForKubernetesNodePoolCreate(ctx, apiClient, clusterID, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.Errorf("Error updating Kubernetes node pool: %s", err)
 	}
@@ -260,9 +217,46 @@ func resourceKubernetesClusterNodePoolUpdate(ctx context.Context, d *schema.Reso
 	return resourceKubernetesClusterNodePoolRead(ctx, d, m)
 }
 
+// function to replace the node pool with a new pool with the new size or label
+func resourceKubernetesClusterNodePoolReplace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+	clusterID := d.Get("cluster_id").(string)
+
+	newID := replacementPoolID(d.Id(), d.Get("label").(string))
+
+	labels := map[string]string{}
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		labels[k] = v.(string)
+	}
+
+	err := createReplacementPool(ctx, apiClient, clusterID, &civogo.KubernetesClusterPoolUpdateConfig{
+		ID:               newID,
+		Count:            d.Get("node_count").(int),
+		Size:             d.Get("size").(string),
+		Labels:           labels,
+		Taints:           expandTaints(d.Get("taint").(*schema.Set)),
+		PublicIPNodePool: d.Get("public_ip_node_pool").(bool),
+		Region:           apiClient.Region,
+	}, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the new pool is tracked from now on, even if the old pool can't be deleted
+	oldID := d.Id()
+	d.SetId(newID)
+	d.Set("label", newID)
+
+	if err := deleteReplacedPool(ctx, apiClient, clusterID, oldID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesClusterNodePoolRead(ctx, d, m)
+}
+
 // function to delete the kubernetes cluster
 func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
 	clusterID := d.Get("cluster_id").(string)
 	getKubernetesCluster, err := apiClient.GetKubernetesCluster(clusterID)
@@ -270,12 +264,7 @@ func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.Reso
 		return diag.Errorf("[INFO] error getting kubernetes cluster: %s", clusterID)
 	}
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] deleting the kubernetes cluster %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("deleting the kubernetes cluster %s", d.Id()))
 	_, err = apiClient.DeleteKubernetesClusterPool(getKubernetesCluster.ID, d.Id())
 	if err != nil {
 		return diag.Errorf("[INFO] an error occurred while trying to delete the kubernetes cluster pool %s", err)
@@ -286,13 +275,13 @@ func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.Reso
 		_, err := apiClient.GetKubernetesClusterPool(getKubernetesCluster.ID, d.Id())
 		if err != nil {
 			if errors.Is(err, civogo.DatabaseClusterPoolNotFoundError) {
-				log.Printf("[INFO] kubernetes node pool %s deleted", d.Id())
+				tflog.Info(ctx, fmt.Sprintf("kubernetes node pool %s deleted", d.Id()))
 				return nil
 			}
-			log.Printf("[INFO] error trying to read kubernetes cluster pool: %s", err)
+			tflog.Info(ctx, fmt.Sprintf("error trying to read kubernetes cluster pool: %s", err))
 			return retry.NonRetryableError(fmt.Errorf("error waiting for Kubernetes node pool to be deleted: %s", err))
 		}
-		log.Printf("[INFO] kubernetes node pool %s still exists", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("kubernetes node pool %s still exists", d.Id()))
 		return retry.RetryableError(fmt.Errorf("kubernetes node pool still exists"))
 	})
 	if err != nil {
@@ -303,14 +292,14 @@ func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.Reso
 }
 
 // custom import to able to add a node pool to the terraform
-func resourceKubernetesClusterNodePoolImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
-	regions, err := apiClient.ListRegions()
+func resourceKubernetesClusterNodePoolImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	regions, err := utils.ClientForRegion(m, "").ListRegions()
 	if err != nil {
 		return nil, err
 	}
 
-	clusterID, nodePoolID, err := utils.ResourceCommonParseID(d.Id())
+	// the cluster can be referred to by its ID or its name
+	clusterRef, nodePoolID, err := utils.ResourceCommonParseID(d.Id())
 	if err != nil {
 		return nil, err
 	}
@@ -322,9 +311,14 @@ func resourceKubernetesClusterNodePoolImport(d *schema.ResourceData, m interface
 		}
 
 		currentRegionCode := region.Code
-		apiClient.Region = currentRegionCode
+		apiClient := utils.ClientForRegion(m, currentRegionCode)
 
-		log.Printf("[INFO] Retriving the node pool %s from region %s", nodePoolID, currentRegionCode)
+		clusterID, err := resolveKubernetesClusterID(apiClient, clusterRef)
+		if err != nil {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("retrieving the node pool %s from region %s", nodePoolID, currentRegionCode))
 		respPool, err := apiClient.GetKubernetesClusterPool(clusterID, nodePoolID)
 		if err != nil {
 			continue
@@ -338,9 +332,8 @@ func resourceKubernetesClusterNodePoolImport(d *schema.ResourceData, m interface
 			d.Set("node_count", respPool.Count)
 			d.Set("size", respPool.Size)
 			d.Set("region", currentRegionCode)
-			if respPool.PublicIPNodePool {
-				d.Set("public_ip_node_pool", respPool.PublicIPNodePool)
-			}
+			d.Set("public_ip_node_pool", respPool.PublicIPNodePool)
+			d.Set("replace_strategy", replaceStrategyRolling)
 		}
 	}
 
@@ -362,63 +355,43 @@ func updateNodePool(s []civogo.KubernetesClusterPoolConfig, id string, count int
 	return s
 }
 
-// waitForKubernetesNodePoolCreate is a utility function to wait for a node pool to be created
-func waitForKubernetesNodePoolCreate(client *civogo.Client, d *schema.ResourceData, clusterID string) error {
-	var (
-		tickerInterval        = 10 * time.Second
-		timeoutSeconds        = d.Timeout(schema.TimeoutCreate).Seconds()
-		timeout               = int(timeoutSeconds / tickerInterval.Seconds())
-		n                     = 0
-		totalRequiredInstance = 0
-		totalRunningInstance  = 0
-		ticker                = time.NewTicker(tickerInterval)
-		nodePoolID            = d.Id()
-	)
-
-	for range ticker.C {
-		cluster, err := client.GetKubernetesCluster(clusterID)
-		if err != nil {
-			ticker.Stop()
-			return fmt.Errorf("error trying to read cluster state: %s", err)
-		}
-
-		for _, v := range cluster.RequiredPools {
-			if v.ID == nodePoolID {
-				totalRequiredInstance = v.Count
-				break
+// waitForKubernetesNodePoolCreate waits until the node pool of the cluster has the number of nodes it
+// requires and all of them are active
+func waitForKubernetesNodePoolCreate(ctx context.Context, apiClient *civogo.Client, clusterID, nodePoolID string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"SCALING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			cluster, err := apiClient.GetKubernetesCluster(clusterID)
+			if err != nil {
+				return 0, "", fmt.Errorf("error trying to read cluster state: %s", err)
 			}
-		}
 
-		for _, v := range cluster.Pools {
-			if v.ID == nodePoolID {
-				totalRunningInstance = v.Count
-				break
-			}
-		}
+			for _, pool := range cluster.Pools {
+				if pool.ID != nodePoolID {
+					continue
+				}
 
-		allRunning := totalRunningInstance == totalRequiredInstance
-		for _, n := range cluster.Pools {
-			if n.ID == nodePoolID {
-				for _, node := range n.Instances {
-					if node.Status != "ACTIVE" {
-						allRunning = false
+				required := pool.Count
+				for _, v := range cluster.RequiredPools {
+					if v.ID == nodePoolID {
+						required = v.Count
+						break
 					}
 				}
+				if len(pool.Instances) == required && poolReady(pool) {
+					return cluster, "ACTIVE", nil
+				}
 			}
-		}
-
-		if allRunning {
-			ticker.Stop()
-			return nil
-		}
-
-		if n > timeout {
-			ticker.Stop()
-			break
-		}
-
-		n++
+			return cluster, "SCALING", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the node pool %s of the kubernetes cluster %s: %s", nodePoolID, clusterID, err)
 	}
 
-	return fmt.Errorf("timeout waiting to create nodepool %s", nodePoolID)
+	return nil
 }
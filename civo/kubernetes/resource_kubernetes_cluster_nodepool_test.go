@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -50,6 +51,25 @@ func TestAccCivoKubernetesClusterNodePool_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resPoolName, "size", "g4s.kube.small"),
 				),
 			},
+			{
+				// import the node pool using the name of the cluster
+				ResourceName:      resPoolName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s:%s", kubernetesClusterName, s.RootModule().Resources[resPoolName].Primary.ID), nil
+				},
+			},
+			{
+				// changing the size replaces the pool with a new pool before deleting the old one
+				Config: CivoKubernetesClusterConfigBasic(kubernetesClusterName) + CivoKubernetesClusterNodePoolConfigSize("g4s.kube.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterNodePoolResourceExists(resPoolName, &kubernetes, &kubernetesNodePool),
+					CivoKubernetesClusterNodePoolValues(&kubernetesNodePool, "g4s.kube.medium"),
+					resource.TestCheckResourceAttr(resPoolName, "size", "g4s.kube.medium"),
+					resource.TestCheckResourceAttr(resPoolName, "replace_strategy", "rolling"),
+				),
+			},
 		},
 	})
 }
@@ -73,7 +93,7 @@ func CivoKubernetesClusterNodePoolResourceExists(n string, kubernetes *civogo.Ku
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.GetKubernetesCluster(kubernetes.ID)
 		if err != nil {
 			return fmt.Errorf("Kuberenetes Cluster not found: (%s) %s", rs.Primary.ID, err)
@@ -105,3 +125,14 @@ resource "civo_kubernetes_node_pool" "foobar" {
 	depends_on = [civo_kubernetes_cluster.foobar]
 }`
 }
+
+func CivoKubernetesClusterNodePoolConfigSize(size string) string {
+	return fmt.Sprintf(`
+resource "civo_kubernetes_node_pool" "foobar" {
+	cluster_id = civo_kubernetes_cluster.foobar.id
+	node_count = 3
+	size = "%s"
+	region = "LON1"
+	depends_on = [civo_kubernetes_cluster.foobar]
+}`, size)
+}
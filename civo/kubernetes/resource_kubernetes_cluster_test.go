@@ -2,10 +2,13 @@ package kubernetes_test
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -86,6 +89,177 @@ func TestAccCivoKubernetesClusterCNI(t *testing.T) {
 	})
 }
 
+func TestAccCivoKubernetesClusterAPIAccessCIDRs(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	// generate a random name for each test run
+	resName := "civo_kubernetes_cluster.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigAPIAccessCIDRs(kubernetesClusterName, "192.168.1.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					resource.TestCheckResourceAttr(resName, "api_access_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "api_access_cidrs.*", "192.168.1.0/24"),
+				),
+			},
+			{
+				Config: CivoKubernetesClusterConfigAPIAccessCIDRs(kubernetesClusterName, "10.0.0.0/8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "api_access_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "api_access_cidrs.*", "10.0.0.0/8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoKubernetesClusterTags(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	// generate a random name for each test run
+	resName := "civo_kubernetes_cluster.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigTags(kubernetesClusterName, `"team=platform"`),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "team=platform"),
+				),
+			},
+			{
+				Config: CivoKubernetesClusterConfigTags(kubernetesClusterName, `"team=platform", "env=prod"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "env=prod"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCivoKubernetesClusterTagsPoolsUpdate tests the nodes keep the tags of the cluster when only the pools change
+func TestAccCivoKubernetesClusterTagsPoolsUpdate(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	// generate a random name for each test run
+	resName := "civo_kubernetes_cluster.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigTagsNodeCount(kubernetesClusterName, `"team=platform"`, 2),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					CivoKubernetesClusterNodesTagged(resName, "team=platform"),
+				),
+			},
+			{
+				Config: CivoKubernetesClusterConfigTagsNodeCount(kubernetesClusterName, `"team=platform"`, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "pools.0.node_count", "3"),
+					CivoKubernetesClusterNodesTagged(resName, "team=platform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoKubernetesClusterFirewall(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	// generate a random name for each test run
+	resName := "civo_kubernetes_cluster.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigFirewall(kubernetesClusterName, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					resource.TestCheckResourceAttrPair(resName, "firewall_id", "civo_firewall.default", "id"),
+				),
+			},
+			{
+				// the cluster is moved to the other firewall without being recreated
+				Config: CivoKubernetesClusterConfigFirewall(kubernetesClusterName, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resName, "id", &kubernetes.ID),
+					resource.TestCheckResourceAttrPair(resName, "firewall_id", "civo_firewall.other", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoKubernetesClusterReplacePool(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	// generate a random name for each test run
+	resName := "civo_kubernetes_cluster.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigPoolSize(kubernetesClusterName, "g4s.kube.small"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					resource.TestCheckResourceAttr(resName, "pools.0.size", "g4s.kube.small"),
+				),
+			},
+			{
+				Config: CivoKubernetesClusterConfigPoolSize(kubernetesClusterName, "g4s.kube.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "pools.0.size", "g4s.kube.medium"),
+					resource.TestCheckResourceAttr(resName, "pools.0.node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCivoKubernetesClusterTalosApplications(t *testing.T) {
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// talos clusters are refused at plan time when they have marketplace applications
+				Config:      CivoKubernetesClusterConfigTalosApplications(kubernetesClusterName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("talos clusters don't support marketplace applications"),
+			},
+		},
+	})
+}
+
 func CivoKubernetesClusterValues(kubernetes *civogo.KubernetesCluster, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if kubernetes.Name != name {
@@ -105,7 +279,7 @@ func CivoKubernetesClusterResourceExists(n string, kubernetes *civogo.Kubernetes
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.GetKubernetesCluster(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Kuberenetes Cluster not found: (%s) %s", rs.Primary.ID, err)
@@ -119,6 +293,36 @@ func CivoKubernetesClusterResourceExists(n string, kubernetes *civogo.Kubernetes
 	}
 }
 
+// CivoKubernetesClusterNodesTagged checks every node of the pools of the cluster has the tag
+func CivoKubernetesClusterNodesTagged(n, tag string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
+		cluster, err := client.GetKubernetesCluster(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Kuberenetes Cluster not found: (%s) %s", rs.Primary.ID, err)
+		}
+
+		for _, pool := range cluster.Pools {
+			for _, node := range pool.Instances {
+				instance, err := client.GetInstance(node.ID)
+				if err != nil {
+					return fmt.Errorf("Node not found: (%s) %s", node.ID, err)
+				}
+				if !slices.Contains(instance.Tags, tag) {
+					return fmt.Errorf("bad tags of the node %s, expected %q in: %v", node.Hostname, tag, instance.Tags)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func CivoKubernetesClusterConfigBasic(name string) string {
 	return fmt.Sprintf(`
 resource "civo_firewall" "default" {
@@ -156,3 +360,122 @@ resource "civo_kubernetes_cluster" "foobar" {
 	cni = "cilium"
 }`, name, name)
 }
+
+func CivoKubernetesClusterConfigAPIAccessCIDRs(name, cidr string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	firewall_id = civo_firewall.default.id
+	api_access_cidrs = ["%s"]
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}`, name, name, cidr)
+}
+
+func CivoKubernetesClusterConfigTags(name, tags string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	firewall_id = civo_firewall.default.id
+	tags = [%s]
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}`, name, name, tags)
+}
+
+func CivoKubernetesClusterConfigTagsNodeCount(name, tags string, nodeCount int) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	firewall_id = civo_firewall.default.id
+	tags = [%s]
+	pools {
+		node_count = %d
+		size = "g4s.kube.small"
+	}
+}`, name, name, tags, nodeCount)
+}
+
+func CivoKubernetesClusterConfigFirewall(name, firewall string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_firewall" "other" {
+	name = "%s-other"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	firewall_id = civo_firewall.%s.id
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}`, name, name, name, firewall)
+}
+
+func CivoKubernetesClusterConfigPoolSize(name, size string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	firewall_id = civo_firewall.default.id
+	pools {
+		node_count = 2
+		size = "%s"
+	}
+}`, name, name, size)
+}
+
+func CivoKubernetesClusterConfigTalosApplications(name string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+	region = "FAKE"
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	cluster_type = "talos"
+	applications = "metrics-server"
+	firewall_id = civo_firewall.default.id
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+}`, name, name)
+}
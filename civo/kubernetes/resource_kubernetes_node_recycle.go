@@ -0,0 +1,183 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceKubernetesNodeRecycle function returns a schema.Resource that recycles the nodes of a node pool one by one.
+// The nodes are recycled when the resource is created, so changing its `triggers` replaces the nodes again.
+func ResourceKubernetesNodeRecycle() *schema.Resource {
+	return &schema.Resource{
+		Description: strings.Join([]string{
+			"Recycles the nodes of a Civo Kubernetes node pool one at a time, waiting for every node to be replaced before recycling the next one. This can be used to roll out OS patches to the nodes.",
+			"The nodes are recycled when the resource is created, change `triggers` to recycle them again. Destroying the resource doesn't change the nodes.",
+		}, "\n\n"),
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the cluster of the node pool",
+			},
+			"node_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID (the label) of the node pool whose nodes are recycled",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that recycle the nodes of the pool again when they change, e.g. the version of a patch",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the cluster, if not declared we use the region declared in the provider",
+			},
+			// computed attributes
+			"recycled_nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The hostnames of the nodes that were recycled",
+			},
+			"recycled_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The timestamp when the nodes finished recycling",
+			},
+		},
+		CreateContext: resourceKubernetesNodeRecycleCreate,
+		ReadContext:   resourceKubernetesNodeRecycleRead,
+		DeleteContext: resourceKubernetesNodeRecycleDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+// poolHostnames returns the hostnames of the nodes of the pool
+func poolHostnames(pool *civogo.KubernetesPool) []string {
+	if len(pool.InstanceNames) > 0 {
+		return append([]string{}, pool.InstanceNames...)
+	}
+
+	hostnames := make([]string, 0, len(pool.Instances))
+	for _, node := range pool.Instances {
+		hostnames = append(hostnames, node.Hostname)
+	}
+
+	return hostnames
+}
+
+// waitForKubernetesNodeRecycle waits until the cluster is active and the pool is back to all its nodes active
+func waitForKubernetesNodeRecycle(ctx context.Context, apiClient *civogo.Client, clusterID, poolID, hostname string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"BUILDING", "SCALING", "RECYCLING", "UPGRADING", "UPDATING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetKubernetesCluster(clusterID)
+			if err != nil {
+				return 0, "", err
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("recycling the node %s, active nodes per pool: %s", hostname, poolUpgradeProgress(resp)))
+			if resp.Status != "ACTIVE" {
+				return resp, resp.Status, nil
+			}
+			for _, pool := range resp.Pools {
+				if pool.ID == poolID && !poolReady(pool) {
+					return resp, "RECYCLING", nil
+				}
+			}
+			return resp, resp.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the kubernetes cluster (%s) to recycle the node %s: %s", clusterID, hostname, err)
+	}
+
+	return nil
+}
+
+// function to recycle the nodes of the pool one by one
+func resourceKubernetesNodeRecycleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	clusterID := d.Get("cluster_id").(string)
+	poolID := d.Get("node_pool_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("retrieving the kubernetes cluster pool %s", poolID))
+	pool, err := apiClient.GetKubernetesClusterPool(clusterID, poolID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to find the kubernetes cluster pool: %s", err)
+	}
+
+	hostnames := poolHostnames(pool)
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	for i, hostname := range hostnames {
+		tflog.Info(ctx, fmt.Sprintf("recycling the node %s (%d/%d) of the kubernetes cluster %s", hostname, i+1, len(hostnames), clusterID))
+		if _, err := apiClient.RecycleKubernetesCluster(clusterID, hostname); err != nil {
+			return diag.Errorf("[ERR] an error occurred while recycling the node %s of the kubernetes cluster %s: %s", hostname, clusterID, err)
+		}
+
+		if err := waitForKubernetesNodeRecycle(ctx, apiClient, clusterID, poolID, hostname, time.Until(deadline)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(uuid.NewString())
+	d.Set("recycled_nodes", hostnames)
+	d.Set("recycled_at", time.Now().UTC().String())
+
+	return resourceKubernetesNodeRecycleRead(ctx, d, m)
+}
+
+// function to read the node pool whose nodes were recycled
+func resourceKubernetesNodeRecycleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
+	clusterID := d.Get("cluster_id").(string)
+	poolID := d.Get("node_pool_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("retrieving the kubernetes cluster pool %s", poolID))
+	if _, err := apiClient.GetKubernetesClusterPool(clusterID, poolID); err != nil {
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "kubernetes node recycle")
+		}
+		return diag.Errorf("[ERR] failed to find the kubernetes cluster pool: %s", err)
+	}
+
+	d.Set("region", apiClient.Region)
+
+	return nil
+}
+
+// function to remove the recycle from the state, the nodes are left as they are
+func resourceKubernetesNodeRecycleDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("removing the recycle of the node pool %s from the state", d.Get("node_pool_id").(string)))
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCivoKubernetesNodeRecycle_basic(t *testing.T) {
+	var kubernetes civogo.KubernetesCluster
+
+	resName := "civo_kubernetes_cluster.foobar"
+	resRecycleName := "civo_kubernetes_node_recycle.foobar"
+	var kubernetesClusterName = acctest.RandomWithPrefix("tf-test") + "-example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoKubernetesClusterConfigBasic(kubernetesClusterName) + CivoKubernetesNodeRecycleConfig("2024-01"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoKubernetesClusterResourceExists(resName, &kubernetes),
+					resource.TestCheckResourceAttrPair(resRecycleName, "cluster_id", resName, "id"),
+					resource.TestCheckResourceAttr(resRecycleName, "recycled_nodes.#", "2"),
+					resource.TestCheckResourceAttrSet(resRecycleName, "recycled_at"),
+				),
+			},
+			{
+				Config: CivoKubernetesClusterConfigBasic(kubernetesClusterName) + CivoKubernetesNodeRecycleConfig("2024-02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resRecycleName, "triggers.patch", "2024-02"),
+					resource.TestCheckResourceAttr(resRecycleName, "recycled_nodes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func CivoKubernetesNodeRecycleConfig(patch string) string {
+	return fmt.Sprintf(`
+resource "civo_kubernetes_node_recycle" "foobar" {
+	cluster_id = civo_kubernetes_cluster.foobar.id
+	node_pool_id = civo_kubernetes_cluster.foobar.pools.0.label
+	triggers = {
+		patch = "%s"
+	}
+}
+`, patch)
+}
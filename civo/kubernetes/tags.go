@@ -0,0 +1,102 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceKubernetesClusterV0 is the part of the schema of the first version of the cluster that changed,
+// its `tags` were a space separated string
+func resourceKubernetesClusterV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceKubernetesClusterStateUpgradeV0 turns the space separated `tags` of the state into a set
+func resourceKubernetesClusterStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	tags := []interface{}{}
+	if value, ok := rawState["tags"].(string); ok {
+		for _, tag := range strings.Fields(value) {
+			tags = append(tags, tag)
+		}
+	}
+	rawState["tags"] = tags
+
+	return rawState, nil
+}
+
+// nodeTags returns the tags of a node once the tags of its cluster change from old to new,
+// the tags of the node that don't come from the cluster are kept
+func nodeTags(tags, old, new []string) []string {
+	previous := map[string]bool{}
+	for _, tag := range old {
+		previous[tag] = true
+	}
+
+	seen := map[string]bool{}
+	merged := []string{}
+	for _, tag := range tags {
+		if previous[tag] || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		merged = append(merged, tag)
+	}
+	for _, tag := range new {
+		if !seen[tag] {
+			seen[tag] = true
+			merged = append(merged, tag)
+		}
+	}
+	sort.Strings(merged)
+
+	return merged
+}
+
+// propagateTagsToNodes sets the tags of the cluster on the instances of its nodes, so the spend of the
+// nodes can be reported by tag. The tags the cluster used to have are removed from the nodes.
+func propagateTagsToNodes(ctx context.Context, apiClient *civogo.Client, id string, old, new []string) error {
+	cluster, err := apiClient.GetKubernetesCluster(id)
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to find the kubernetes cluster: %s", err)
+	}
+
+	for _, pool := range cluster.Pools {
+		for _, node := range pool.Instances {
+			if node.ID == "" {
+				continue
+			}
+
+			instance, err := apiClient.GetInstance(node.ID)
+			if err != nil {
+				return fmt.Errorf("[ERR] failed to retrieve the node %s of the kubernetes cluster %s: %s", node.Hostname, id, err)
+			}
+
+			tags := nodeTags(instance.Tags, old, new)
+			current := append([]string{}, instance.Tags...)
+			sort.Strings(current)
+			if strings.Join(tags, " ") == strings.Join(current, " ") {
+				continue
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("tagging the node %s of the kubernetes cluster %s", node.Hostname, id))
+			if _, err := apiClient.SetInstanceTags(instance, strings.Join(tags, " ")); err != nil {
+				return fmt.Errorf("[ERR] an error occurred while tagging the node %s of the kubernetes cluster %s: %s", node.Hostname, id, err)
+			}
+		}
+	}
+
+	return nil
+}
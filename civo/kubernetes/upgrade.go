@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// versionCustomizeDiff refuses to plan a downgrade of the kubernetes version of an existing cluster,
+// the Civo API can only upgrade a cluster in place
+func versionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("kubernetes_version") || !d.NewValueKnown("kubernetes_version") {
+		return nil
+	}
+
+	old, new := d.GetChange("kubernetes_version")
+	if old.(string) == "" || new.(string) == "" {
+		return nil
+	}
+
+	oldVersion, err := version.NewVersion(old.(string))
+	if err != nil {
+		return nil
+	}
+	newVersion, err := version.NewVersion(new.(string))
+	if err != nil {
+		return nil
+	}
+
+	if newVersion.LessThan(oldVersion) {
+		return fmt.Errorf("[ERR] the kubernetes cluster can't be downgraded from %s to %s", old.(string), new.(string))
+	}
+
+	return nil
+}
+
+// poolUpgradeProgress returns how many nodes of every pool are active, to log the progress of an upgrade
+func poolUpgradeProgress(cluster *civogo.KubernetesCluster) string {
+	progress := make([]string, 0, len(cluster.Pools))
+	for _, pool := range cluster.Pools {
+		active := 0
+		for _, node := range pool.Instances {
+			if node.Status == "ACTIVE" {
+				active++
+			}
+		}
+		progress = append(progress, fmt.Sprintf("%s %d/%d", pool.ID, active, pool.Count))
+	}
+
+	return strings.Join(progress, ", ")
+}
+
+// upgradeKubernetesCluster upgrades the cluster to the kubernetes version and waits until the
+// cluster runs the new version, logging how many nodes of every pool are active while it waits
+func upgradeKubernetesCluster(ctx context.Context, apiClient *civogo.Client, id, kubernetesVersion string, timeout time.Duration) error {
+	tflog.Info(ctx, fmt.Sprintf("upgrading the kubernetes cluster %s to %s", id, kubernetesVersion))
+	_, err := apiClient.UpdateKubernetesCluster(id, &civogo.KubernetesClusterConfig{
+		KubernetesVersion: kubernetesVersion,
+		Region:            apiClient.Region,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to upgrade the kubernetes cluster %s to %s: %s", id, kubernetesVersion, err)
+	}
+
+	upgradeStateConf := &resource.StateChangeConf{
+		Pending: []string{"UPGRADING", "BUILDING", "SCALING", "AVAILABLE"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetKubernetesCluster(id)
+			if err != nil {
+				return 0, "", err
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("upgrading the kubernetes cluster %s, active nodes per pool: %s", id, poolUpgradeProgress(resp)))
+			if resp.Status == "ACTIVE" && resp.KubernetesVersion != kubernetesVersion {
+				// the upgrade hasn't started yet
+				return resp, "UPGRADING", nil
+			}
+			return resp, resp.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := upgradeStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the kubernetes cluster (%s) to be upgraded to %s: %s", id, kubernetesVersion, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// waitForInstances waits until the cluster and every node of its pools are active
+	waitForInstances = "instances"
+	// waitForControlPlane waits until the API server of the cluster can be reached with the kubeconfig
+	waitForControlPlane = "control_plane"
+	// waitForNone doesn't wait for the cluster once it's requested
+	waitForNone = "none"
+)
+
+// poolStatusSchema is the schema of the status of every node pool of a cluster, to debug stuck applies
+func poolStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The status of the node pools of the cluster",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"label": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The label of the node pool",
+				},
+				"node_count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of nodes requested for the node pool",
+				},
+				"ready_count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of nodes of the node pool that are active",
+				},
+				"failing_instances": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The hostnames of the nodes of the node pool that aren't active",
+				},
+			},
+		},
+	}
+}
+
+// flattenPoolStatus function to flatten the status of the node pools of a cluster
+func flattenPoolStatus(cluster *civogo.KubernetesCluster) []interface{} {
+	flattenedStatus := make([]interface{}, 0, len(cluster.Pools))
+	for _, pool := range cluster.Pools {
+		ready := 0
+		failing := make([]string, 0)
+		for _, node := range pool.Instances {
+			if node.Status == "ACTIVE" {
+				ready++
+				continue
+			}
+			failing = append(failing, node.Hostname)
+		}
+
+		flattenedStatus = append(flattenedStatus, map[string]interface{}{
+			"label":             pool.ID,
+			"node_count":        pool.Count,
+			"ready_count":       ready,
+			"failing_instances": failing,
+		})
+	}
+
+	return flattenedStatus
+}
+
+// poolReady reports whether the node pool has all its nodes active
+func poolReady(pool civogo.KubernetesPool) bool {
+	ready := 0
+	for _, node := range pool.Instances {
+		if node.Status == "ACTIVE" {
+			ready++
+		}
+	}
+
+	return ready >= pool.Count
+}
+
+// poolsReady reports whether every node pool of the cluster has all its nodes active
+func poolsReady(cluster *civogo.KubernetesCluster) bool {
+	for _, pool := range cluster.Pools {
+		if !poolReady(pool) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// waitForKubernetesCluster waits for the cluster according to `wait_for`, logging how many nodes
+// of every pool are active while it waits so a stuck apply can be debugged
+func waitForKubernetesCluster(ctx context.Context, apiClient *civogo.Client, id, waitFor string, timeout time.Duration) error {
+	if waitFor == waitForNone {
+		tflog.Info(ctx, fmt.Sprintf("not waiting for the kubernetes cluster %s to be ready", id))
+		return nil
+	}
+
+	var cluster *civogo.KubernetesCluster
+	createStateConf := &resource.StateChangeConf{
+		Pending: []string{"BUILDING", "AVAILABLE", "UPGRADING", "SCALING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetKubernetesCluster(id)
+			if err != nil {
+				return 0, "", err
+			}
+			cluster = resp
+
+			tflog.Debug(ctx, fmt.Sprintf("waiting for the kubernetes cluster %s, active nodes per pool: %s", id, poolUpgradeProgress(resp)))
+			switch waitFor {
+			case waitForControlPlane:
+				if resp.APIEndPoint != "" && resp.KubeConfig != "" {
+					return resp, "ACTIVE", nil
+				}
+			case waitForInstances:
+				if resp.Status == "ACTIVE" && !poolsReady(resp) {
+					return resp, "SCALING", nil
+				}
+			}
+			return resp, resp.Status, nil
+		},
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     3 * time.Second,
+		NotFoundChecks: 10,
+	}
+	if _, err := createStateConf.WaitForStateContext(ctx); err != nil {
+		if cluster != nil {
+			return fmt.Errorf("error waiting for cluster (%s) to be created, active nodes per pool: %s: %s", id, poolUpgradeProgress(cluster), err)
+		}
+		return fmt.Errorf("error waiting for cluster (%s) to be created: %s", id, err)
+	}
+
+	return nil
+}
@@ -2,10 +2,11 @@ package loadbalancer
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -123,21 +124,16 @@ func DataSourceLoadBalancer() *schema.Resource {
 	}
 }
 
-func dataSourceLoadBalancerRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func dataSourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	var searchBy string
 
 	if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the LoadBalancer by name")
+		tflog.Info(ctx, "Getting the LoadBalancer by name")
 		searchBy = name.(string)
 	} else if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the LoadBalancer by id")
+		tflog.Info(ctx, "Getting the LoadBalancer by id")
 		searchBy = id.(string)
 	}
 
@@ -0,0 +1,105 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// networkAttachments returns the resources still in the network, which keep it from being deleted: the
+// instances, the kubernetes clusters, the databases and the volumes of the network
+func networkAttachments(apiClient *civogo.Client, networkID string) ([]string, error) {
+	attachments := []string{}
+
+	instances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the instances: %s", err)
+	}
+	for _, instance := range instances {
+		if instance.NetworkID == networkID {
+			attachments = append(attachments, fmt.Sprintf("instance %s (%s)", instance.Hostname, instance.ID))
+		}
+	}
+
+	clusters, err := apiClient.ListKubernetesClusters()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the kubernetes clusters: %s", err)
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.NetworkID == networkID {
+			attachments = append(attachments, fmt.Sprintf("kubernetes cluster %s (%s)", cluster.Name, cluster.ID))
+		}
+	}
+
+	databases, err := apiClient.ListDatabases()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the databases: %s", err)
+	}
+	for _, database := range databases.Items {
+		if database.NetworkID == networkID {
+			attachments = append(attachments, fmt.Sprintf("database %s (%s)", database.Name, database.ID))
+		}
+	}
+
+	volumes, err := apiClient.ListVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the volumes: %s", err)
+	}
+	for _, volume := range volumes {
+		if volume.NetworkID == networkID {
+			attachments = append(attachments, fmt.Sprintf("volume %s (%s)", volume.Name, volume.ID))
+		}
+	}
+
+	return attachments, nil
+}
+
+// networkDeleteError describes why the network couldn't be deleted, listing the resources still in it when
+// there are any, as the error of the API doesn't tell which resources keep the network from being deleted
+func networkDeleteError(apiClient *civogo.Client, networkID string, err error) error {
+	attachments, listErr := networkAttachments(apiClient, networkID)
+	if listErr != nil || len(attachments) == 0 {
+		return fmt.Errorf("[ERR] error waiting for network (%s) to be deleted: %s", networkID, err)
+	}
+
+	return fmt.Errorf("[ERR] the network %s can't be deleted while it has resources, delete them or move them to another network first: %s (%s)", networkID, strings.Join(attachments, ", "), err)
+}
+
+// waitForNetworkAttachments waits until the network has no resources, and fails listing them when they're still
+// in the network after the timeout
+func waitForNetworkAttachments(ctx context.Context, apiClient *civogo.Client, networkID string, timeout time.Duration) error {
+	var attachments []string
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"attached"},
+		Target:  []string{"detached"},
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			attachments, err = networkAttachments(apiClient, networkID)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(attachments) > 0 {
+				tflog.Info(ctx, fmt.Sprintf("waiting for the resources of the network %s to be deleted: %s", networkID, strings.Join(attachments, ", ")))
+				return attachments, "attached", nil
+			}
+			return attachments, "detached", nil
+		},
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if len(attachments) > 0 {
+			return fmt.Errorf("[ERR] the network %s can't be deleted while it has resources, delete them or move them to another network first: %s", networkID, strings.Join(attachments, ", "))
+		}
+		return fmt.Errorf("[ERR] error waiting for the resources of the network %s to be deleted: %s", networkID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// networkCIDRCustomizeDiff refuses to plan a network whose CIDR overlaps the CIDR of another network of the
+// region, so instances in the networks can still be routed to. The check is skipped when the networks can't be listed.
+func networkCIDRCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("cidr_v4") || d.Get("cidr_v4").(string) == "" || (d.Id() != "" && !d.HasChange("cidr_v4")) {
+		return nil
+	}
+
+	_, cidr, err := net.ParseCIDR(d.Get("cidr_v4").(string))
+	if err != nil {
+		return nil
+	}
+
+	_, apiClient, err := utils.DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	networks, err := apiClient.ListNetworks()
+	if err != nil {
+		tflog.Warn(ctx, "unable to list the networks to validate the CIDR of the network", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	for _, network := range networks {
+		if network.ID == d.Id() || network.CIDR == "" {
+			continue
+		}
+
+		_, networkCIDR, err := net.ParseCIDR(network.CIDR)
+		if err != nil {
+			continue
+		}
+		if utils.CIDRsOverlap(cidr, networkCIDR) {
+			return fmt.Errorf("[ERR] the CIDR %s overlaps the CIDR %s of the network %s, use another CIDR", cidr, network.CIDR, network.Label)
+		}
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package network
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +20,8 @@ func DataSourceNetwork() *schema.Resource {
 		Description: strings.Join([]string{
 			"Retrieve information about a network for use in other resources.",
 			"This data source provides all of the network's properties as configured on your Civo account.",
-			"Networks may be looked up by id or label, and you can optionally pass region if you want to make a lookup for a specific network inside that region.",
+			"Networks may be looked up by id, label or name, and you can optionally pass region if you want to make a lookup for a specific network inside that region. When only the region is set, the default network of the region is returned.",
+			"Note: You can use the `civo_networks` data source to list the networks of a region and filter them.",
 		}, "\n\n"),
 		ReadContext: dataSourceNetworkRead,
 		Schema: map[string]*schema.Schema{
@@ -26,63 +29,125 @@ func DataSourceNetwork() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				AtLeastOneOf: []string{"id", "label", "region"},
+				AtLeastOneOf: []string{"id", "label", "name", "region"},
 			},
 			"label": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				AtLeastOneOf: []string{"id", "label", "region"},
+				AtLeastOneOf: []string{"id", "label", "name", "region"},
 				Description:  "The label of an existing network",
 			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				AtLeastOneOf: []string{"id", "label", "region"},
+				AtLeastOneOf: []string{"id", "label", "name", "region"},
 				Description:  "The region of an existing network",
 			},
-			// Computed resource
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The name of the network",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				AtLeastOneOf: []string{"id", "label", "name", "region"},
+				Description:  "The name of an existing network",
 			},
+			// Computed resource
 			"default": {
 				Type:        schema.TypeBool,
 				Computed:    true,
 				Description: "If is the default network",
 			},
+			"cidr_v4": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR block of the network",
+			},
+			"nameservers_v4": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The nameservers of the network",
+			},
+			"ipv6_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If the network allocates IPv6 addresses to its instances",
+			},
+			"cidr_v6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IPv6 CIDR block of the network, empty if IPv6 is not enabled",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the network",
+			},
 		},
 	}
 }
 
-func dataSourceNetworkRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+// findNetworkByLabelOrName returns the network whose label or name is exactly the given value, and errors when
+// no network or more than one network match
+func findNetworkByLabelOrName(apiClient *civogo.Client, value string) (*civogo.Network, error) {
+	networks, err := apiClient.ListNetworks()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the networks: %s", err)
+	}
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	found := []civogo.Network{}
+	for _, network := range networks {
+		if network.Label == value || network.Name == value {
+			found = append(found, network)
+		}
 	}
 
+	if len(found) == 0 {
+		return nil, fmt.Errorf("[ERR] no network labelled or named %s was found in the region %s", value, apiClient.Region)
+	}
+	if len(found) > 1 {
+		ids := make([]string, 0, len(found))
+		for _, network := range found {
+			ids = append(ids, network.ID)
+		}
+		return nil, fmt.Errorf("[ERR] more than one network labelled or named %s was found in the region %s (%s), use the id instead", value, apiClient.Region, strings.Join(ids, ", "))
+	}
+
+	return &found[0], nil
+}
+
+func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+
 	var foundNetwork *civogo.Network
+	var err error
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the network by id")
-		network, err := apiClient.FindNetwork(id.(string))
+		tflog.Info(ctx, "Getting the network by id")
+		foundNetwork, err = apiClient.GetNetwork(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive network: %s", err)
 		}
-
-		foundNetwork = network
 	} else if label, ok := d.GetOk("label"); ok {
-		log.Printf("[INFO] Getting the network by label")
-		network, err := apiClient.FindNetwork(label.(string))
+		tflog.Info(ctx, "Getting the network by label")
+		foundNetwork, err = findNetworkByLabelOrName(apiClient, label.(string))
 		if err != nil {
-			return diag.Errorf("[ERR] failed to retrive network: %s", err)
+			return diag.FromErr(err)
+		}
+	} else if name, ok := d.GetOk("name"); ok {
+		tflog.Info(ctx, "Getting the network by name")
+		foundNetwork, err = findNetworkByLabelOrName(apiClient, name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		tflog.Info(ctx, "Getting the default network of the region")
+		foundNetwork, err = apiClient.GetDefaultNetwork()
+		if err != nil {
+			return diag.Errorf("[ERR] failed to retrive the default network: %s", err)
 		}
-
-		foundNetwork = network
 	}
 
 	d.SetId(foundNetwork.ID)
@@ -90,6 +155,11 @@ func dataSourceNetworkRead(_ context.Context, d *schema.ResourceData, m interfac
 	d.Set("label", foundNetwork.Label)
 	d.Set("region", apiClient.Region)
 	d.Set("default", foundNetwork.Default)
+	d.Set("cidr_v4", foundNetwork.CIDR)
+	d.Set("nameservers_v4", foundNetwork.NameserversV4)
+	d.Set("ipv6_enabled", foundNetwork.IPv6Enabled)
+	d.Set("cidr_v6", foundNetwork.CIDRV6)
+	d.Set("status", foundNetwork.Status)
 
 	return nil
 }
@@ -0,0 +1,127 @@
+package network
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceNetworks Data source to get and filter all networks with filter
+func DataSourceNetworks() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on networks for use in other resources, with the ability to filter and sort the results. If no filters are specified, all networks of the region will be returned.",
+			"Note: You can use the `civo_network` data source to obtain metadata about a single network if you already know the id, label or name to retrieve.",
+		}, "\n\n"),
+		RecordSchema: networksSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all networks will be from the provided region",
+			},
+		},
+		ResultAttributeName: "networks",
+		FlattenRecord:       flattenDataSourceNetworks,
+		GetRecords:          getDataSourceNetworks,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceNetworks(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
+
+	var networks []interface{}
+	partialNetworks, err := apiClient.ListNetworks()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving networks: %s", err)
+	}
+
+	for _, partialNetwork := range partialNetworks {
+		networks = append(networks, partialNetwork)
+	}
+
+	return networks, nil
+}
+
+func flattenDataSourceNetworks(network, m interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+	// the networks are listed in the region of the provider when the data source doesn't declare one
+	region = utils.ClientForRegion(m, region).Region
+
+	n := network.(civogo.Network)
+
+	flattenedNetwork := map[string]interface{}{}
+	flattenedNetwork["id"] = n.ID
+	flattenedNetwork["name"] = n.Name
+	flattenedNetwork["label"] = n.Label
+	flattenedNetwork["region"] = region
+	flattenedNetwork["default"] = n.Default
+	flattenedNetwork["cidr_v4"] = n.CIDR
+	flattenedNetwork["nameservers_v4"] = n.NameserversV4
+	flattenedNetwork["ipv6_enabled"] = n.IPv6Enabled
+	flattenedNetwork["cidr_v6"] = n.CIDRV6
+	flattenedNetwork["status"] = n.Status
+
+	return flattenedNetwork, nil
+}
+
+func networksSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "ID of the network",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the network",
+		},
+		"label": {
+			Type:        schema.TypeString,
+			Description: "Label of the network",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "Region of the network",
+		},
+		"default": {
+			Type:        schema.TypeBool,
+			Description: "Whether the network is the default network of the region",
+		},
+		"cidr_v4": {
+			Type:        schema.TypeString,
+			Description: "CIDR block of the network",
+		},
+		"nameservers_v4": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Nameservers of the network",
+		},
+		"ipv6_enabled": {
+			Type:        schema.TypeBool,
+			Description: "Whether the network allocates IPv6 addresses to its instances",
+		},
+		"cidr_v6": {
+			Type:        schema.TypeString,
+			Description: "IPv6 CIDR block of the network, empty if IPv6 is not enabled",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the network",
+		},
+	}
+}
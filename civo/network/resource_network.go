@@ -2,21 +2,27 @@ package network
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceNetwork function returns a schema.Resource that represents a Network.
 // This can be used to create, read, update, and delete operations for a Network in the infrastructure.
 func ResourceNetwork() *schema.Resource {
 	return &schema.Resource{
-		Description: "Provides a Civo network resource. This can be used to create, modify, and delete networks.",
+		Description: strings.Join([]string{
+			"Provides a Civo network resource. This can be used to create, modify, and delete networks.",
+			"A network can't be deleted while it has instances, Kubernetes clusters, databases or volumes. The deletion waits for them to be deleted until the delete timeout, and then fails listing the resources still in the network.",
+		}, "\n\n"),
 		Schema: map[string]*schema.Schema{
 			"label": {
 				Type:         schema.TypeString,
@@ -32,21 +38,36 @@ func ResourceNetwork() *schema.Resource {
 				Description: "The region of the network",
 			},
 			"cidr_v4": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The CIDR block for the network",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.ValidatePrivateCIDR,
+				Description:  "The CIDR block for the network, it must be within the private ranges of RFC 1918 and can't overlap the CIDR of another network of the region. Changing it replaces the network",
 			},
 			"nameservers_v4": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
 				},
 				Computed:    true,
 				Description: "List of nameservers for the network",
 			},
+			"ipv6_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "If true, the network allocates IPv6 addresses to its instances, only available in the regions supporting IPv6",
+			},
 			// Computed resource
+			"cidr_v6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IPv6 CIDR block of the network, empty if IPv6 is not enabled",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -58,34 +79,41 @@ func ResourceNetwork() *schema.Resource {
 				Description: "If the network is default, this will be `true`",
 			},
 			// VLAN Network
+			"vlan": vlanSchema(),
 			"vlan_id": {
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Deprecated:  "This field will be deprecated in the next major release, please use the 'vlan' block instead",
 				Description: "VLAN ID for the network",
 			},
 			"vlan_cidr_v4": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "This field will be deprecated in the next major release, please use the 'vlan' block instead",
 				Description: "CIDR for VLAN IPv4",
 			},
 			"vlan_gateway_ip_v4": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "This field will be deprecated in the next major release, please use the 'vlan' block instead",
 				Description: "Gateway IP for VLAN IPv4",
 			},
 			"vlan_physical_interface": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "This field will be deprecated in the next major release, please use the 'vlan' block instead",
 				Description: "Physical interface for VLAN",
 			},
 			"vlan_allocation_pool_v4_start": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "This field will be deprecated in the next major release, please use the 'vlan' block instead",
 				Description: "Start of the IPv4 allocation pool for VLAN",
 			},
 			"vlan_allocation_pool_v4_end": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "This field will be deprecated in the next major release, please use the 'vlan' block instead",
 				Description: "End of the IPv4 allocation pool for VLAN",
 			},
 		},
@@ -93,45 +121,37 @@ func ResourceNetwork() *schema.Resource {
 		ReadContext:   resourceNetworkRead,
 		UpdateContext: resourceNetworkUpdate,
 		DeleteContext: resourceNetworkDelete,
+		CustomizeDiff: networkCIDRCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
 // function to create a new network
 func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] creating the new network %s", d.Get("label").(string))
-	vlanConfig := civogo.VLANConnectConfig{
-		VlanID:                d.Get("vlan_id").(int),
-		PhysicalInterface:     d.Get("vlan_physical_interface").(string),
-		CIDRv4:                d.Get("vlan_cidr_v4").(string),
-		GatewayIPv4:           d.Get("vlan_gateway_ip_v4").(string),
-		AllocationPoolV4Start: d.Get("vlan_allocation_pool_v4_start").(string),
-		AllocationPoolV4End:   d.Get("vlan_allocation_pool_v4_end").(string),
-	}
+	apiClient := utils.ResourceClient(m, d)
 
+	tflog.Info(ctx, fmt.Sprintf("creating the new network %s", d.Get("label").(string)))
 	configs := civogo.NetworkConfig{
 		Label:         d.Get("label").(string),
 		CIDRv4:        d.Get("cidr_v4").(string),
 		Region:        apiClient.Region,
 		NameserversV4: expandStringList(d.Get("nameservers_v4")),
+		VLanConfig:    expandVLAN(d),
 	}
-	// Only add VLAN configuration if VLAN ID is set
-	if vlanConfig.VlanID > 0 {
-		configs.VLanConfig = &vlanConfig
+	if d.Get("ipv6_enabled").(bool) {
+		ipv6Enabled := true
+		configs.IPv6Enabled = &ipv6Enabled
 	}
 
 	// Retry the network creation using the utility function
-	err := utils.RetryUntilSuccessOrTimeout(func() error {
-		log.Printf("[INFO] Attempting to create the network %s", d.Get("label").(string))
+	err := utils.RetryUntilSuccessOrTimeout(ctx, func() error {
+		tflog.Info(ctx, fmt.Sprintf("Attempting to create the network %s", d.Get("label").(string)))
 		network, err := apiClient.CreateNetwork(configs)
 		if err != nil {
 			return err
@@ -148,24 +168,14 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 // function to read a network
-func resourceNetworkRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	CurrentNetwork := civogo.Network{}
 
-	log.Printf("[INFO] retriving the network %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the network %s", d.Id()))
 	resp, err := apiClient.ListNetworks()
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
-		}
-
 		return diag.Errorf("[ERR] failed to list the network: %s", err)
 	}
 
@@ -175,54 +185,72 @@ func resourceNetworkRead(_ context.Context, d *schema.ResourceData, m interface{
 		}
 	}
 
+	if CurrentNetwork.ID == "" {
+		return utils.RemoveFromState(ctx, d, "network")
+	}
+
 	d.Set("name", CurrentNetwork.Name)
 	d.Set("region", apiClient.Region)
 	d.Set("label", CurrentNetwork.Label)
 	d.Set("default", CurrentNetwork.Default)
 	d.Set("cidr_v4", CurrentNetwork.CIDR)
 	d.Set("nameservers_v4", CurrentNetwork.NameserversV4)
+	d.Set("ipv6_enabled", CurrentNetwork.IPv6Enabled)
+	d.Set("cidr_v6", CurrentNetwork.CIDRV6)
+	if d.Get("vlan_id").(int) == 0 {
+		if err := d.Set("vlan", flattenVLAN(d, CurrentNetwork)); err != nil {
+			return diag.Errorf("[ERR] error setting vlan: %s", err)
+		}
+	}
 
 	return nil
 }
 
 // function to update the network
 func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	if d.HasChange("label") {
-		log.Printf("[INFO] updating the network %s", d.Id())
+		tflog.Info(ctx, fmt.Sprintf("updating the network %s", d.Id()))
 		_, err := apiClient.RenameNetwork(d.Get("label").(string), d.Id())
 		if err != nil {
 			return diag.Errorf("[ERR] An error occurred while rename the network %s", d.Id())
 		}
-		return resourceNetworkRead(ctx, d, m)
 	}
+
+	if d.HasChange("nameservers_v4") {
+		tflog.Info(ctx, fmt.Sprintf("updating the nameservers of the network %s", d.Id()))
+		_, err := apiClient.UpdateNetwork(d.Id(), civogo.NetworkConfig{
+			Label:         d.Get("label").(string),
+			NameserversV4: expandStringList(d.Get("nameservers_v4")),
+			Region:        apiClient.Region,
+		})
+		if err != nil {
+			return diag.Errorf("[ERR] An error occurred while updating the nameservers of the network %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceNetworkRead(ctx, d, m)
 }
 
 // function to delete a network
-func resourceNetworkDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	netowrkID := d.Id()
-	log.Printf("[INFO] Checking if firewall %s exists", netowrkID)
+	tflog.Info(ctx, fmt.Sprintf("Checking if firewall %s exists", netowrkID))
 	_, err := apiClient.FindNetwork(netowrkID)
 	if err != nil {
-		log.Printf("[INFO] Unable to find network %s - probably it's been deleted", netowrkID)
+		tflog.Info(ctx, fmt.Sprintf("Unable to find network %s - probably it's been deleted", netowrkID))
 		return nil
 	}
 
-	log.Printf("[INFO] deleting the network %s", netowrkID)
+	// the resources of the network deleted in the same apply may still be being deleted, they're waited for
+	if err := waitForNetworkAttachments(ctx, apiClient, netowrkID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deleting the network %s", netowrkID))
 
 	deleteStateConf := &resource.StateChangeConf{
 		Pending: []string{"failed"},
@@ -234,14 +262,14 @@ func resourceNetworkDelete(_ context.Context, d *schema.ResourceData, m interfac
 			}
 			return resp, string(resp.Result), nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutDelete),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 10,
 	}
 	_, err = deleteStateConf.WaitForStateContext(context.Background())
 	if err != nil {
-		return diag.Errorf("error waiting for network (%s) to be deleted: %s", netowrkID, err)
+		return diag.FromErr(networkDeleteError(apiClient, netowrkID, err))
 	}
 
 	return nil
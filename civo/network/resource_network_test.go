@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -75,6 +76,38 @@ func TestAccCivoNetwork_update(t *testing.T) {
 	})
 }
 
+func TestAccCivoNetwork_cidr(t *testing.T) {
+	var network civogo.Network
+
+	// generate a random name for each test run
+	resName := "civo_network.foobar"
+	var networkLabel = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoNetworkConfigCIDR(networkLabel, "8.8.8.8"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoNetworkResourceExists(resName, &network),
+					resource.TestCheckResourceAttr(resName, "cidr_v4", "10.123.0.0/24"),
+					resource.TestCheckResourceAttr(resName, "nameservers_v4.0", "8.8.8.8"),
+				),
+			},
+			{
+				// the nameservers are updated in place
+				Config: CivoNetworkConfigCIDR(networkLabel, "1.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					CivoNetworkResourceExists(resName, &network),
+					resource.TestCheckResourceAttr(resName, "nameservers_v4.0", "1.1.1.1"),
+				),
+			},
+		},
+	})
+}
+
 func CivoNetworkValues(network *civogo.Network, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if network.Label != name {
@@ -94,7 +127,7 @@ func CivoNetworkResourceExists(n string, network *civogo.Network) resource.TestC
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.FindNetwork(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Network not found: (%s) %s", rs.Primary.ID, err)
@@ -118,7 +151,7 @@ func CivoNetworkUpdated(network *civogo.Network, name string) resource.TestCheck
 }
 
 func CivoNetworkDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_network" {
@@ -147,3 +180,12 @@ resource "civo_network" "foobar" {
 	label = "%s"
 }`, label)
 }
+
+func CivoNetworkConfigCIDR(label, nameserver string) string {
+	return fmt.Sprintf(`
+resource "civo_network" "foobar" {
+	label = "%s"
+	cidr_v4 = "10.123.0.0/24"
+	nameservers_v4 = ["%s"]
+}`, label, nameserver)
+}
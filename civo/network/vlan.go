@@ -0,0 +1,131 @@
+package network
+
+import (
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vlanAttributes are the deprecated top level attributes of the VLAN of a network, replaced by the `vlan` block
+var vlanAttributes = []string{
+	"vlan_id",
+	"vlan_cidr_v4",
+	"vlan_gateway_ip_v4",
+	"vlan_physical_interface",
+	"vlan_allocation_pool_v4_start",
+	"vlan_allocation_pool_v4_end",
+}
+
+// vlanSchema is the schema of the VLAN a network is connected to, only available in CivoStack regions
+func vlanSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: vlanAttributes,
+		Description:   "The VLAN the network is connected to, for hybrid connectivity in CivoStack regions. Changing it replaces the network",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"vlan_id": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1, 4094),
+					Description:  "The ID of the VLAN",
+				},
+				"physical_interface": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The physical interface of the hosts the VLAN is connected to",
+				},
+				"cidr_v4": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsCIDR,
+					Description:  "The IPv4 CIDR of the VLAN",
+				},
+				"gateway_ip_v4": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsIPv4Address,
+					Description:  "The IPv4 address of the gateway of the VLAN",
+				},
+				"allocation_pool_v4_start": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Computed:     true,
+					ValidateFunc: validation.IsIPv4Address,
+					Description:  "The first IPv4 address of the range the instances of the network get their address from",
+				},
+				"allocation_pool_v4_end": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Computed:     true,
+					ValidateFunc: validation.IsIPv4Address,
+					Description:  "The last IPv4 address of the range the instances of the network get their address from",
+				},
+			},
+		},
+	}
+}
+
+// expandVLAN returns the VLAN configuration of the network from the `vlan` block, or from the deprecated
+// top level attributes when the block isn't set. It's nil when the network isn't connected to a VLAN.
+func expandVLAN(d *schema.ResourceData) *civogo.VLANConnectConfig {
+	if v, ok := d.GetOk("vlan"); ok {
+		vlan := v.([]interface{})[0].(map[string]interface{})
+		return &civogo.VLANConnectConfig{
+			VlanID:                vlan["vlan_id"].(int),
+			PhysicalInterface:     vlan["physical_interface"].(string),
+			CIDRv4:                vlan["cidr_v4"].(string),
+			GatewayIPv4:           vlan["gateway_ip_v4"].(string),
+			AllocationPoolV4Start: vlan["allocation_pool_v4_start"].(string),
+			AllocationPoolV4End:   vlan["allocation_pool_v4_end"].(string),
+		}
+	}
+
+	if vlanID := d.Get("vlan_id").(int); vlanID > 0 {
+		return &civogo.VLANConnectConfig{
+			VlanID:                vlanID,
+			PhysicalInterface:     d.Get("vlan_physical_interface").(string),
+			CIDRv4:                d.Get("vlan_cidr_v4").(string),
+			GatewayIPv4:           d.Get("vlan_gateway_ip_v4").(string),
+			AllocationPoolV4Start: d.Get("vlan_allocation_pool_v4_start").(string),
+			AllocationPoolV4End:   d.Get("vlan_allocation_pool_v4_end").(string),
+		}
+	}
+
+	return nil
+}
+
+// flattenVLAN function to flatten the VLAN of the network, the CIDR of the VLAN isn't returned by the API so
+// the configured one is kept
+func flattenVLAN(d *schema.ResourceData, network civogo.Network) []interface{} {
+	if network.VlanID == 0 {
+		return nil
+	}
+
+	cidr := d.Get("vlan.0.cidr_v4").(string)
+	if cidr == "" {
+		cidr = network.CIDR
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vlan_id":                  network.VlanID,
+			"physical_interface":       network.PhysicalInterface,
+			"cidr_v4":                  cidr,
+			"gateway_ip_v4":            network.GatewayIPv4,
+			"allocation_pool_v4_start": network.AllocationPoolV4Start,
+			"allocation_pool_v4_end":   network.AllocationPoolV4End,
+		},
+	}
+}
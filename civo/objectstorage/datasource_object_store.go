@@ -2,10 +2,11 @@ package objectstorage
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,17 +64,12 @@ func DataSourceObjectStore() *schema.Resource {
 }
 
 func dataSourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	var foundStore *civogo.ObjectStore
 
 	if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the Object Store by name")
+		tflog.Info(ctx, "Getting the Object Store by name")
 		store, err := apiClient.FindObjectStore(name.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive Object Store: %s", err)
@@ -83,7 +79,7 @@ func dataSourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the Object Store by name")
+		tflog.Info(ctx, "Getting the Object Store by name")
 		store, err := apiClient.FindObjectStore(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive Object Store: %s", err)
@@ -2,10 +2,11 @@ package objectstorage
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -58,17 +59,12 @@ func DataSourceObjectStoreCredential() *schema.Resource {
 }
 
 func dataSourceObjectStoreCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	var foundStoreCredential *civogo.ObjectStoreCredential
 
 	if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the Object Store Credential by name")
+		tflog.Info(ctx, "Getting the Object Store Credential by name")
 		storeCredential, err := apiClient.FindObjectStoreCredential(name.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive Object Store Credential: %s", err)
@@ -78,7 +74,7 @@ func dataSourceObjectStoreCredentialRead(ctx context.Context, d *schema.Resource
 	}
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the Object Store Credential by name")
+		tflog.Info(ctx, "Getting the Object Store Credential by name")
 		storeCredential, err := apiClient.FindObjectStoreCredential(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrive Object Store Credential: %s", err)
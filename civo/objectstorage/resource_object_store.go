@@ -2,11 +2,12 @@ package objectstorage
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,14 +69,9 @@ func ResourceObjectStore() *schema.Resource {
 
 // Function to create an Object Store
 func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] configuring the Object Store %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("configuring the Object Store %s", d.Get("name").(string)))
 	config := &civogo.CreateObjectStoreRequest{
 		Name:      d.Get("name").(string),
 		MaxSizeGB: int64(d.Get("max_size_gb").(int)),
@@ -86,7 +82,7 @@ func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m in
 		config.AccessKeyID = AccessKeyID.(string)
 	}
 
-	log.Printf("[INFO] creating the Object Store %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("creating the Object Store %s", d.Get("name").(string)))
 	store, err := apiClient.NewObjectStore(config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create Object Store: %s", err)
@@ -104,7 +100,7 @@ func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m in
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 60,
@@ -120,19 +116,13 @@ func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m in
 
 // Function to read Object Store
 func resourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retriving the Object Store %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the Object Store %s", d.Id()))
 	resp, err := apiClient.GetObjectStore(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "object store")
 		}
 
 		return diag.Errorf("[ERR] failed to retrive the Object Store: %s", err)
@@ -150,12 +140,7 @@ func resourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 
 // Function to update the Object Store
 func resourceObjectStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	_, err := apiClient.FindObjectStore(d.Id())
 	if err != nil {
@@ -170,7 +155,7 @@ func resourceObjectStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 		config.MaxSizeGB = int64(d.Get("max_size_gb").(int))
 	}
 
-	log.Printf("[INFO] updating the Object Store %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("updating the Object Store %s", d.Id()))
 	_, err = apiClient.UpdateObjectStore(d.Id(), config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to update Object Store: %s", err)
@@ -181,14 +166,9 @@ func resourceObjectStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 // Function to delete an Object Store
 func resourceObjectStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] deleting the Object Store %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("deleting the Object Store %s", d.Id()))
 	_, err := apiClient.DeleteObjectStore(d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the Object Store %s", d.Id())
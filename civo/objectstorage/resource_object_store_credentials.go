@@ -2,11 +2,12 @@ package objectstorage
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,14 +64,9 @@ func ResourceObjectStoreCredential() *schema.Resource {
 
 // Function to create an Object Store Credential
 func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] configuring the Object Store Credential %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("configuring the Object Store Credential %s", d.Get("name").(string)))
 	config := &civogo.CreateObjectStoreCredentialRequest{
 		Name:   d.Get("name").(string),
 		Region: apiClient.Region,
@@ -86,7 +82,7 @@ func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.Resource
 		config.SecretAccessKeyID = &SecretAccessKeyID
 	}
 
-	log.Printf("[INFO] creating the Object Store Credential %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("creating the Object Store Credential %s", d.Get("name").(string)))
 	storeCredential, err := apiClient.NewObjectStoreCredential(config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create Object Store Credential: %s", err)
@@ -104,7 +100,7 @@ func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.Resource
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 60,
@@ -119,19 +115,13 @@ func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.Resource
 
 // Function to read Object Store Credential
 func resourceObjectStoreCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retriving the Object Store Credential %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retriving the Object Store Credential %s", d.Id()))
 	resp, err := apiClient.GetObjectStoreCredential(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "object store credential")
 		}
 		return diag.Errorf("[ERR] failed to retrive the Object Store Credential: %s", err)
 	}
@@ -146,12 +136,7 @@ func resourceObjectStoreCredentialRead(ctx context.Context, d *schema.ResourceDa
 
 // Function to update the Object Store Credential
 func resourceObjectStoreCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	_, err := apiClient.FindObjectStoreCredential(d.Id())
 	if err != nil {
@@ -172,7 +157,7 @@ func resourceObjectStoreCredentialUpdate(ctx context.Context, d *schema.Resource
 		config.SecretAccessKeyID = &secretKey
 	}
 
-	log.Printf("[INFO] updating the Object Store Credential %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("updating the Object Store Credential %s", d.Id()))
 	_, err = apiClient.UpdateObjectStoreCredential(d.Id(), config)
 	if err != nil {
 		return diag.Errorf("[ERR] failed to update Object Store Credential: %s", err)
@@ -183,14 +168,9 @@ func resourceObjectStoreCredentialUpdate(ctx context.Context, d *schema.Resource
 
 // Function to delete an Object Store Credential
 func resourceObjectStoreCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] deleting the Object Store Credential %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("deleting the Object Store Credential %s", d.Id()))
 	_, err := apiClient.DeleteObjectStoreCredential(d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the Object Store Credential %s", d.Id())
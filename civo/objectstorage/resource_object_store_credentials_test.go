@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -133,7 +134,7 @@ func CivoObjectStoreCredentialResourceExists(n string, storeCredential *civogo.O
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.FindObjectStoreCredential(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Object Store Credential not found: (%s) %s", rs.Primary.ID, err)
@@ -156,7 +157,7 @@ func CivoObjectStoreCredentialUpdated(storeCredential *civogo.ObjectStoreCredent
 }
 
 func CivoObjectStoreCredentialDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_object_store_credential" {
@@ -1,8 +1,11 @@
 package civo
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"strings"
+	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/database"
@@ -19,7 +22,11 @@ import (
 	"github.com/civo/terraform-provider-civo/civo/size"
 	"github.com/civo/terraform-provider-civo/civo/ssh"
 	"github.com/civo/terraform-provider-civo/civo/volume"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -32,7 +39,7 @@ var (
 
 // Provider Civo cloud provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:        schema.TypeString,
@@ -40,74 +47,338 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CIVO_TOKEN", ""),
 				Description: "This is the Civo API token. Alternatively, this can also be specified using `CIVO_TOKEN` environment variable.",
 			},
+			"token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A command run through the shell when the provider is configured, its output is used as the Civo API token. Takes precedence over `token`, useful to read the token from a secret manager.",
+			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to the civo CLI config file to read the token and region from when they are not set in the provider or in the environment, by default `~/.civo.json` is used if it exists.",
+			},
+			"api_keys": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A map of account names to Civo API tokens, a resource or data source uses one of them when its `account` argument is set to the account name",
+			},
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CIVO_REGION", ""),
 				Description: "If region is not set, then no region will be used and them you need expensify in every resource even if you expensify here you can overwrite in a resource.",
 			},
+			"validate_credentials": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the token is checked against the Civo API when the provider is configured, so an invalid key, a region not enabled for the account or a suspended account fail before any resource is touched",
+			},
+			"skip_region_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip checking that the region exists in the Civo API when the provider is configured",
+			},
 			"api_endpoint": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CIVO_API_URL", ProdAPI),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The Base URL to use for CIVO API, useful to target a staging API or a mock server. Alternatively, this can also be specified using `CIVO_API_URL` environment variable.",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("CIVO_API_URL", ProdAPI),
-				Description: "The Base URL to use for CIVO API.",
+				MaxItems:    1,
+				Description: "Tags added to every resource that supports tags (`civo_instance` and `civo_kubernetes_cluster`), on top of the tags declared in the resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The list of default tags",
+						},
+					},
+				},
+			},
+			"default_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description:  "A duration like `90m` used instead of the built-in default of every create, read, update and delete timeout that is not set in the `timeouts` block of a resource",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CIVO_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of times an operation is retried when the Civo API rate limits a request or returns a transient error, set it to 0 to disable retries. Reads are retried on both, creates, updates and deletes only when a request is rate limited, and creates only until the resource is created. Alternatively, this can also be specified using `CIVO_MAX_RETRIES` environment variable.",
+			},
+			"max_concurrent_operations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CIVO_MAX_CONCURRENT_OPERATIONS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of resource and data source operations (create, read, update or delete) running at the same time, shared by every resource of the provider. An operation holds its slot until it finishes, including the time it waits for the resource to be ready, so a few slow operations such as creating kubernetes clusters can make the others wait. It doesn't limit the individual API requests. 0 means no limit. Alternatively, this can also be specified using `CIVO_MAX_CONCURRENT_OPERATIONS` environment variable.",
+			},
+			"cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How long in seconds the sizes, regions, disk images and versions fetched from the Civo API are cached by the provider, 0 disables the cache",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The minimum time in seconds to wait before retrying a request, the wait is doubled on every retry",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum time in seconds to wait before retrying a request",
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			// "civo_template":           dataSourceTemplate(),
-			"civo_disk_image":              disk.DataSourceDiskImage(),
-			"civo_kubernetes_version":      kubernetes.DataSourceKubernetesVersion(),
-			"civo_kubernetes_cluster":      kubernetes.DataSourceKubernetesCluster(),
-			"civo_size":                    size.DataSourceSize(),
-			"civo_instances":               instances.DataSourceInstances(),
-			"civo_instance":                instances.DataSourceInstance(),
-			"civo_dns_domain_name":         dns.DataSourceDNSDomainName(),
-			"civo_dns_domain_record":       dns.DataSourceDNSDomainRecord(),
-			"civo_network":                 network.DataSourceNetwork(),
-			"civo_volume":                  volume.DataSourceVolume(),
-			"civo_firewall":                firewall.DataSourceFirewall(),
-			"civo_loadbalancer":            loadbalancer.DataSourceLoadBalancer(),
-			"civo_ssh_key":                 ssh.DataSourceSSHKey(),
-			"civo_object_store":            objectstorage.DataSourceObjectStore(),
-			"civo_object_store_credential": objectstorage.DataSourceObjectStoreCredential(),
-			"civo_region":                  region.DataSourceRegion(),
-			"civo_reserved_ip":             ip.DataSourceReservedIP(),
-			"civo_database":                database.DataSourceDatabase(),
-			"civo_database_version":        database.DataDatabaseVersion(),
+			"civo_disk_image":                          disk.DataSourceDiskImage(),
+			"civo_disk_images":                         disk.DataSourceDiskImages(),
+			"civo_kubernetes_version":                  kubernetes.DataSourceKubernetesVersion(),
+			"civo_kubernetes_cluster":                  kubernetes.DataSourceKubernetesCluster(),
+			"civo_kubernetes_clusters":                 kubernetes.DataSourceKubernetesClusters(),
+			"civo_kubernetes_cluster_kubeconfig":       kubernetes.DataSourceKubernetesClusterKubeconfig(),
+			"civo_kubernetes_cluster_instances":        kubernetes.DataSourceKubernetesClusterInstances(),
+			"civo_kubernetes_marketplace_applications": kubernetes.DataSourceKubernetesMarketplaceApplications(),
+			"civo_size":                                size.DataSourceSize(),
+			"civo_instances":                           instances.DataSourceInstances(),
+			"civo_instance":                            instances.DataSourceInstance(),
+			"civo_dns_domain_name":                     dns.DataSourceDNSDomainName(),
+			"civo_dns_domain_names":                    dns.DataSourceDNSDomainNames(),
+			"civo_dns_domain_record":                   dns.DataSourceDNSDomainRecord(),
+			"civo_dns_domain_records":                  dns.DataSourceDNSDomainRecords(),
+			"civo_network":                             network.DataSourceNetwork(),
+			"civo_networks":                            network.DataSourceNetworks(),
+			"civo_volume":                              volume.DataSourceVolume(),
+			"civo_volumes":                             volume.DataSourceVolumes(),
+			"civo_firewall":                            firewall.DataSourceFirewall(),
+			"civo_firewalls":                           firewall.DataSourceFirewalls(),
+			"civo_loadbalancer":                        loadbalancer.DataSourceLoadBalancer(),
+			"civo_ssh_key":                             ssh.DataSourceSSHKey(),
+			"civo_object_store":                        objectstorage.DataSourceObjectStore(),
+			"civo_object_store_credential":             objectstorage.DataSourceObjectStoreCredential(),
+			"civo_region":                              region.DataSourceRegion(),
+			"civo_reserved_ip":                         ip.DataSourceReservedIP(),
+			"civo_database":                            database.DataSourceDatabase(),
+			"civo_database_version":                    database.DataDatabaseVersion(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"civo_instance":                        instances.ResourceInstance(),
 			"civo_instance_reserved_ip_assignment": instances.ResourceInstanceReservedIPAssignment(),
+			"civo_reverse_dns":                     instances.ResourceReverseDNS(),
 			"civo_network":                         network.ResourceNetwork(),
 			"civo_volume":                          volume.ResourceVolume(),
 			"civo_volume_attachment":               volume.ResourceVolumeAttachment(),
 			"civo_dns_domain_name":                 dns.ResourceDNSDomainName(),
 			"civo_dns_domain_record":               dns.ResourceDNSDomainRecord(),
+			"civo_dns_zone_records":                dns.ResourceDNSZoneRecords(),
 			"civo_firewall":                        firewall.ResourceFirewall(),
 			"civo_ssh_key":                         ssh.ResourceSSHKey(),
 			"civo_kubernetes_cluster":              kubernetes.ResourceKubernetesCluster(),
 			"civo_kubernetes_node_pool":            kubernetes.ResourceKubernetesClusterNodePool(),
+			"civo_kubernetes_application":          kubernetes.ResourceKubernetesApplication(),
+			"civo_kubernetes_node_recycle":         kubernetes.ResourceKubernetesNodeRecycle(),
 			"civo_reserved_ip":                     ip.ResourceReservedIP(),
 			"civo_object_store":                    objectstorage.ResourceObjectStore(),
 			"civo_object_store_credential":         objectstorage.ResourceObjectStoreCredential(),
 			"civo_database":                        database.ResourceDatabase(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		if err := setDefaultTimeouts(provider, d); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		meta, err := providerConfigure(ctx, d)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		var diags diag.Diagnostics
+		if d.Get("validate_credentials").(bool) {
+			diags = validateCredentials(ctx, meta.(*utils.ProviderMeta).Client)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		if !d.Get("skip_region_validation").(bool) {
+			if err := validateRegion(ctx, meta.(*utils.ProviderMeta).Client); err != nil {
+				return nil, append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return meta, diags
+	}
+
+	// select the account of every resource and data source, retry their reads and writes when the API rate limits us, add the Civo
+	// error details to the diagnostics, cap the operations running at the same time and redact the secrets from the logs
+	for name, r := range provider.ResourcesMap {
+		r.Schema["account"] = utils.AccountSchema()
+		utils.WithAccount(r)
+		r.ReadContext = utils.RetryReadContext(r.ReadContext)
+		utils.RetryWriteContext(r)
+		utils.WithErrorDetails(r)
+		utils.LimitConcurrency(r)
+		utils.WithLogging(name, r)
+	}
+	for name, r := range provider.DataSourcesMap {
+		r.Schema["account"] = utils.DataSourceAccountSchema()
+		utils.WithAccount(r)
+		r.ReadContext = utils.RetryReadContext(r.ReadContext)
+		utils.WithErrorDetails(r)
+		utils.LimitConcurrency(r)
+		utils.WithLogging(name, r)
+	}
+
+	return provider
+}
+
+// setDefaultTimeouts replaces the built-in default timeouts of the resources with the `default_timeout` of the provider,
+// the timeouts are only read when planning so the ones set in the `timeouts` block of a resource still win
+func setDefaultTimeouts(provider *schema.Provider, d *schema.ResourceData) error {
+	attr, ok := d.GetOk("default_timeout")
+	if !ok {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(attr.(string))
+	if err != nil {
+		return fmt.Errorf("[ERR] default_timeout is not a valid duration: %s", err)
+	}
+
+	for _, r := range provider.ResourcesMap {
+		if r.Timeouts == nil {
+			continue
+		}
+		for _, t := range []**time.Duration{&r.Timeouts.Create, &r.Timeouts.Read, &r.Timeouts.Update, &r.Timeouts.Delete} {
+			if *t != nil {
+				*t = schema.DefaultTimeout(timeout)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCredentials calls an authenticated endpoint of the Civo API to check the token can be used in the region of the provider
+func validateCredentials(ctx context.Context, client *civogo.Client) diag.Diagnostics {
+	quota, err := client.GetQuota()
+	if err != nil {
+		switch {
+		case errors.Is(err, civogo.AuthenticationError):
+			return diag.Errorf("[ERR] the Civo API token is invalid or has been revoked, check the token or create a new API key in the Civo dashboard: %s", err)
+		case errors.Is(err, civogo.RegionUnavailableError):
+			return diag.Errorf("[ERR] the region %s is not enabled for this Civo account: %s", client.Region, err)
+		case errors.Is(err, civogo.DisabledServiceError):
+			return diag.Errorf("[ERR] the Civo account is suspended or the service is disabled, contact Civo support: %s", err)
+		default:
+			return diag.Errorf("[ERR] unable to validate the Civo API token: %s", err)
+		}
+	}
+
+	tflog.Debug(ctx, "the Civo API token is valid", map[string]interface{}{"account_email": quota.DefaultUserEmailAddress})
+	if quota.InstanceCountLimit == 0 && quota.CPUCoreLimit == 0 {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "The Civo account has no quota",
+				Detail:   "The instance and CPU quota of the account are 0, so no resource can be created until the quota is raised. Contact Civo support if the account was suspended.",
+			},
+		}
+	}
+
+	return nil
+}
+
+// validateRegion checks the region of the provider is one of the regions available in the Civo API,
+// so a typo in the region fails when the provider is configured instead of on the first API call
+func validateRegion(ctx context.Context, client *civogo.Client) error {
+	if client.Region == "" {
+		return nil
+	}
+
+	regions, err := client.ListRegions()
+	if err != nil {
+		return fmt.Errorf("[ERR] unable to list the regions to validate the region %s: %s", client.Region, err)
+	}
+
+	codes := make([]string, 0, len(regions))
+	for _, region := range regions {
+		if strings.EqualFold(region.Code, client.Region) {
+			tflog.Debug(ctx, "the region of the provider is valid", map[string]interface{}{"region": region.Code})
+			return nil
+		}
+		codes = append(codes, region.Code)
+	}
+
+	return fmt.Errorf("[ERR] the region %s is not available, the valid regions are: %s", client.Region, strings.Join(codes, ", "))
+}
+
+// validateDuration checks that the value can be parsed by time.ParseDuration
+func validateDuration(v interface{}, k string) (ws []string, es []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be a valid duration like 30m or 1h: %s", k, err))
+	}
+	return
 }
 
 // Provider configuration
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
 	var regionValue, tokenValue, apiURL string
 	var client *civogo.Client
 	var err error
 
+	if command, ok := d.GetOk("token_command"); ok {
+		tokenValue, err = runTokenCommand(command.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// fall back to the civo CLI config only when the token or the region are missing
+	credentials := &cliCredentials{}
+	_, hasToken := d.GetOk("token")
+	hasToken = hasToken || tokenValue != ""
+	_, hasRegion := d.GetOk("region")
+	if !hasToken || !hasRegion {
+		credentials, err = readCredentialsFile(d.Get("credentials_file").(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if region, ok := d.GetOk("region"); ok {
 		regionValue = region.(string)
+	} else {
+		regionValue = credentials.Region
 	}
 
-	if token, ok := d.GetOk("token"); ok {
+	if tokenValue != "" {
+		tflog.Debug(ctx, "using the token from token_command")
+	} else if token, ok := d.GetOk("token"); ok {
 		tokenValue = token.(string)
+	} else if credentials.Token != "" {
+		tflog.Debug(ctx, "using the token from the civo CLI credentials file")
+		tokenValue = credentials.Token
 	} else {
 		return nil, fmt.Errorf("[ERR] token not found")
 	}
@@ -117,6 +388,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	} else {
 		apiURL = ProdAPI
 	}
+	retryWaitMin := d.Get("retry_wait_min").(int)
+	retryWaitMax := d.Get("retry_wait_max").(int)
+	if retryWaitMin > retryWaitMax {
+		return nil, fmt.Errorf("[ERR] retry_wait_min (%d) can't be greater than retry_wait_max (%d)", retryWaitMin, retryWaitMax)
+	}
+
 	client, err = civogo.NewClientWithURL(tokenValue, apiURL, regionValue)
 	if err != nil {
 		return nil, err
@@ -128,6 +405,41 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	client.SetUserAgent(userAgent)
 
-	log.Printf("[DEBUG] Civo API URL: %s\n", apiURL)
-	return client, nil
+	accounts := map[string]*civogo.Client{}
+	for account, token := range d.Get("api_keys").(map[string]interface{}) {
+		if token.(string) == "" {
+			return nil, fmt.Errorf("[ERR] the API key of the account %q is empty", account)
+		}
+
+		accountClient, err := civogo.NewClientWithURL(token.(string), apiURL, regionValue)
+		if err != nil {
+			return nil, err
+		}
+		accountClient.SetUserAgent(userAgent)
+		accounts[account] = accountClient
+	}
+
+	defaultTags := []string{}
+	if attr, ok := d.GetOk("default_tags"); ok && attr.([]interface{})[0] != nil {
+		defaultTags = utils.SetToStrings(attr.([]interface{})[0].(map[string]interface{})["tags"].(*schema.Set))
+	}
+
+	var semaphore chan struct{}
+	if maxConcurrentOperations := d.Get("max_concurrent_operations").(int); maxConcurrentOperations > 0 {
+		semaphore = make(chan struct{}, maxConcurrentOperations)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("civo API URL: %s", apiURL))
+	return &utils.ProviderMeta{
+		Client:      client,
+		Accounts:    accounts,
+		DefaultTags: defaultTags,
+		Semaphore:   semaphore,
+		Cache:       utils.NewCache(time.Duration(d.Get("cache_ttl").(int)) * time.Second),
+		Retry: utils.RetryConfig{
+			MaxRetries: d.Get("max_retries").(int),
+			WaitMin:    time.Duration(retryWaitMin) * time.Second,
+			WaitMax:    time.Duration(retryWaitMax) * time.Second,
+		},
+	}, nil
 }
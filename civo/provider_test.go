@@ -2,10 +2,17 @@ package civo
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
 	"testing"
 
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -27,7 +34,8 @@ func TestProvider_impl(t *testing.T) {
 func TestToken(t *testing.T) {
 	rawProvider := Provider()
 	raw := map[string]interface{}{
-		"token": "123456789",
+		"token":                  "123456789",
+		"skip_region_validation": true,
 	}
 
 	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
@@ -36,6 +44,208 @@ func TestToken(t *testing.T) {
 	}
 }
 
+// TestAPIEndpoint tests the api_endpoint configuration
+func TestAPIEndpoint(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                  "123456789",
+		"skip_region_validation": true,
+		"api_endpoint":           "http://localhost:3000",
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := utils.ProviderClient(rawProvider.Meta())
+	if client.BaseURL.String() != "http://localhost:3000" {
+		t.Fatalf("expected the client to use the api_endpoint, got %s", client.BaseURL.String())
+	}
+}
+
+// TestTokenCommand tests reading the token from the output of token_command
+func TestTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command needs a POSIX shell")
+	}
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                  "ignored",
+		"skip_region_validation": true,
+		"token_command":          "echo 123456789",
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := utils.ProviderClient(rawProvider.Meta())
+	if client.APIKey != "123456789" {
+		t.Fatalf("expected the token from token_command, got %s", client.APIKey)
+	}
+}
+
+// TestCredentialsFile tests reading the token and region from the civo CLI config file
+func TestCredentialsFile(t *testing.T) {
+	t.Setenv("CIVO_TOKEN", "")
+	t.Setenv("CIVO_REGION", "")
+
+	path := filepath.Join(t.TempDir(), "civo.json")
+	content := `{"apikeys":{"tf":"123456789"},"meta":{"current_apikey":"tf","default_region":"FRA1"}}`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("unable to write the credentials file: %s", err)
+	}
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"credentials_file":       path,
+		"skip_region_validation": true,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	client := utils.ProviderClient(rawProvider.Meta())
+	if client.APIKey != "123456789" {
+		t.Fatalf("expected the token from the credentials file, got %s", client.APIKey)
+	}
+	if client.Region != "FRA1" {
+		t.Fatalf("expected the region from the credentials file, got %s", client.Region)
+	}
+}
+
+// TestDefaultTags tests the default_tags configuration
+func TestDefaultTags(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                  "123456789",
+		"skip_region_validation": true,
+		"default_tags": []interface{}{
+			map[string]interface{}{
+				"tags": []interface{}{"team-infra", "env-prod"},
+			},
+		},
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	tags := utils.MergeDefaultTags(rawProvider.Meta(), []string{"web", "env-prod"})
+	if strings.Join(tags, " ") != "env-prod team-infra web" {
+		t.Fatalf("expected the default tags to be merged, got %v", tags)
+	}
+}
+
+// TestDefaultTimeout tests the default_timeout configuration
+func TestDefaultTimeout(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                  "123456789",
+		"skip_region_validation": true,
+		"default_timeout":        "90m",
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	timeouts := rawProvider.ResourcesMap["civo_instance"].Timeouts
+	if *timeouts.Create != 90*time.Minute || *timeouts.Delete != 90*time.Minute {
+		t.Fatalf("expected the instance timeouts to be 90m, got create %s and delete %s", *timeouts.Create, *timeouts.Delete)
+	}
+}
+
+// TestRegionValidation tests the region is checked against the regions of the API
+func TestRegionValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`[{"code":"LON1"},{"code":"FRA1"}]`))
+	}))
+	defer server.Close()
+
+	configure := func(region string) diag.Diagnostics {
+		raw := map[string]interface{}{
+			"token":        "123456789",
+			"region":       region,
+			"api_endpoint": server.URL,
+		}
+		return Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	}
+
+	if diags := configure("lon1"); diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	diags := configure("NYC9")
+	if !diags.HasError() {
+		t.Fatal("expected an error for a region that doesn't exist")
+	}
+	if !strings.Contains(diagnosticsToString(diags), "LON1, FRA1") {
+		t.Fatalf("expected the error to list the valid regions, got %s", diagnosticsToString(diags))
+	}
+}
+
+// TestCatalogCache tests the catalog data is only fetched once within the cache_ttl
+func TestCatalogCache(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                  "123456789",
+		"skip_region_validation": true,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"g3.small"}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := utils.Cached(rawProvider.Meta(), "sizes/LON1", fetch); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected the sizes to be fetched once, got %d calls", calls)
+	}
+}
+
+// TestValidateCredentials tests an invalid token fails the provider configuration
+func TestValidateCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusUnauthorized)
+		rw.Write([]byte(`{"result":"requires_authentication","status":401}`))
+	}))
+	defer server.Close()
+
+	raw := map[string]interface{}{
+		"token":                  "123456789",
+		"api_endpoint":           server.URL,
+		"validate_credentials":   true,
+		"skip_region_validation": true,
+	}
+
+	diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if !diags.HasError() {
+		t.Fatal("expected an error for an invalid token")
+	}
+	if !strings.Contains(diagnosticsToString(diags), "token is invalid") {
+		t.Fatalf("expected an invalid token error, got %s", diagnosticsToString(diags))
+	}
+}
+
 func diagnosticsToString(diags diag.Diagnostics) string {
 	diagsAsStrings := make([]string, len(diags))
 	for i, diag := range diags {
@@ -44,3 +254,33 @@ func diagnosticsToString(diags diag.Diagnostics) string {
 
 	return strings.Join(diagsAsStrings, "; ")
 }
+
+// TestAPIKeys tests the api_keys configuration
+func TestAPIKeys(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":                  "123456789",
+		"skip_region_validation": true,
+		"api_keys": map[string]interface{}{
+			"staging": "987654321",
+		},
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+
+	meta := rawProvider.Meta().(*utils.ProviderMeta)
+	staging, err := meta.ForAccount("staging")
+	if err != nil {
+		t.Fatalf("expected the staging account to be found: %s", err)
+	}
+	if staging.Client.APIKey != "987654321" || meta.Client.APIKey != "123456789" {
+		t.Fatalf("expected the staging account to use its own API key, got %s", staging.Client.APIKey)
+	}
+
+	if _, err := meta.ForAccount("production"); err == nil {
+		t.Fatalf("expected an error for an account not declared in api_keys")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,10 +47,10 @@ func DataSourceRegion() *schema.Resource {
 }
 
 func getRegios(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
 	regions := []interface{}{}
-	partialRegions, err := apiClient.ListRegions()
+	partialRegions, err := utils.Cached(m, "regions", apiClient.ListRegions)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving regions: %s", err)
 	}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,8 +27,15 @@ type Size struct {
 // The retrieved Instance Size can then be used to define the size for other resources or data sources.
 func DataSourceSize() *schema.Resource {
 	dataListConfig := &datalist.ResourceConfig{
-		Description:         "Retrieves information about the sizes that Civo supports, with the ability to filter the results.",
-		RecordSchema:        sizeSchema(),
+		Description:  "Retrieves information about the sizes that Civo supports, with the ability to filter the results.",
+		RecordSchema: sizeSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all sizes will be from the provided region",
+			},
+		},
 		ResultAttributeName: "sizes",
 		FlattenRecord:       flattenSize,
 		GetRecords:          getSizes,
@@ -38,11 +45,17 @@ func DataSourceSize() *schema.Resource {
 
 }
 
-func getSizes(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+func getSizes(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
 
 	sizes := []interface{}{}
-	partialSizes, err := apiClient.ListInstanceSizes()
+	partialSizes, err := utils.Cached(m, "sizes/"+apiClient.Region, apiClient.ListInstanceSizes)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving sizes: %s", err)
 	}
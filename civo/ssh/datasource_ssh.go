@@ -2,10 +2,10 @@ package ssh
 
 import (
 	"context"
-	"log"
 	"strings"
 
-	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,16 +44,16 @@ func DataSourceSSHKey() *schema.Resource {
 	}
 }
 
-func dataSourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func dataSourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
 	var searchBy string
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the ssh key by id")
+		tflog.Info(ctx, "Getting the ssh key by id")
 		searchBy = id.(string)
 	} else if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the ssh key by label")
+		tflog.Info(ctx, "Getting the ssh key by label")
 		searchBy = name.(string)
 	}
 
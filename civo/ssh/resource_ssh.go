@@ -2,10 +2,10 @@ package ssh
 
 import (
 	"context"
-	"log"
+	"fmt"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -47,9 +47,9 @@ func ResourceSSHKey() *schema.Resource {
 
 // function to create a new ssh key
 func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] creating the new ssh key %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("creating the new ssh key %s", d.Get("name").(string)))
 	sshKey, err := apiClient.NewSSHKey(d.Get("name").(string), d.Get("public_key").(string))
 	if err != nil {
 		return diag.Errorf("[ERR] failed to create a new ssh key: %s", err)
@@ -61,15 +61,14 @@ func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 // function to read a ssh key
-func resourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] retrieving the new ssh key %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("retrieving the new ssh key %s", d.Get("name").(string)))
 	sshKey, err := apiClient.FindSSHKey(d.Id())
 	if err != nil {
-		if sshKey == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "ssh key")
 		}
 
 		return diag.Errorf("[ERR] error retrieving ssh key: %s", err)
@@ -83,11 +82,11 @@ func resourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}
 
 // function to update the ssh key
 func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ProviderClient(m)
 
 	if d.HasChange("name") {
 		if d.Get("name").(string) != "" {
-			log.Printf("[INFO] updating the ssh key %s", d.Get("name").(string))
+			tflog.Info(ctx, fmt.Sprintf("updating the ssh key %s", d.Get("name").(string)))
 			_, err := apiClient.UpdateSSHKey(d.Get("name").(string), d.Id())
 			if err != nil {
 				return diag.Errorf("[ERR] an error occurred while trying to rename the ssh key %s", d.Id())
@@ -99,10 +98,10 @@ func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 // function to delete the ssh key
-func resourceSSHKeyDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceSSHKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ProviderClient(m)
 
-	log.Printf("[INFO] deleting the ssh key %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("deleting the ssh key %s", d.Id()))
 	_, err := apiClient.DeleteSSHKey(d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the ssh key %s", d.Id())
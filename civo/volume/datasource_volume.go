@@ -2,10 +2,12 @@ package volume
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,7 +40,7 @@ func DataSourceVolume() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
-				Description:  "The region where volume is running",
+				Description:  "The region where volume is running, the volume is looked up in this region. If not declared we use the region declared in the provider",
 			},
 			// Computed resource
 			"size_gb": {
@@ -51,6 +53,26 @@ func DataSourceVolume() *schema.Resource {
 				Computed:    true,
 				Description: "The mount point of the volume",
 			},
+			"network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network of the volume",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the instance the volume is attached to, empty when the volume isn't attached",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume, e.g. available or attached",
+			},
+			"bootable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the volume can be used to boot an instance",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -60,27 +82,22 @@ func DataSourceVolume() *schema.Resource {
 	}
 }
 
-func dataSourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func dataSourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	var foundVolume *civogo.Volume
 
 	if id, ok := d.GetOk("id"); ok {
-		log.Printf("[INFO] Getting the volume by id")
-		volume, err := apiClient.FindVolume(id.(string))
+		tflog.Info(ctx, "Getting the volume by id")
+		volume, err := apiClient.GetVolume(id.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrieve volume: %s", err)
 		}
 
 		foundVolume = volume
 	} else if name, ok := d.GetOk("name"); ok {
-		log.Printf("[INFO] Getting the volume by name")
-		volume, err := apiClient.FindVolume(name.(string))
+		tflog.Info(ctx, "Getting the volume by name")
+		volume, err := findVolumeByName(apiClient, name.(string))
 		if err != nil {
 			return diag.Errorf("[ERR] failed to retrieve volume: %s", err)
 		}
@@ -96,7 +113,40 @@ func dataSourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface
 	d.Set("name", foundVolume.Name)
 	d.Set("size_gb", foundVolume.SizeGigabytes)
 	d.Set("mount_point", foundVolume.MountPoint)
+	d.Set("network_id", foundVolume.NetworkID)
+	d.Set("instance_id", foundVolume.InstanceID)
+	d.Set("status", foundVolume.Status)
+	d.Set("bootable", foundVolume.Bootable)
 	d.Set("created_at", foundVolume.CreatedAt.UTC().String())
 
 	return nil
 }
+
+// findVolumeByName returns the volume whose name is exactly the value, FindVolume of civogo matches names
+// partially and would return another volume when the value is the prefix of a single one
+func findVolumeByName(apiClient *civogo.Client, name string) (*civogo.Volume, error) {
+	volumes, err := apiClient.ListVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to list the volumes: %s", err)
+	}
+
+	var found []civogo.Volume
+	for _, volume := range volumes {
+		if volume.Name == name {
+			found = append(found, volume)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("[ERR] no volume found with the name %s in the region %s", name, apiClient.Region)
+	case 1:
+		return &found[0], nil
+	}
+
+	ids := make([]string, 0, len(found))
+	for _, volume := range found {
+		ids = append(ids, volume.ID)
+	}
+	return nil, fmt.Errorf("[ERR] there are %d volumes named %s (%s), use the id to look one of them up", len(found), name, strings.Join(ids, ", "))
+}
@@ -22,6 +22,8 @@ func TestAccDataSourceCivoVolume_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(datasourceName, "name", name),
 					resource.TestCheckResourceAttrSet(datasourceName, "size_gb"),
+					resource.TestCheckResourceAttr(datasourceName, "status", "available"),
+					resource.TestCheckResourceAttr(datasourceName, "instance_id", ""),
 				),
 			},
 		},
@@ -0,0 +1,137 @@
+package volume
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceVolumes Data source to get and filter all volumes with filter
+func DataSourceVolumes() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: strings.Join([]string{
+			"Get information on the volumes of a region, with the ability to filter and sort the results. If no filters are specified, all volumes of the region will be returned.",
+			"The volumes can be filtered by `status` or `attached`, for example to find the volumes that aren't attached to an instance and attach them again.",
+			"Note: You can use the `civo_volume` data source to obtain metadata about a single volume if you already know the id or name to retrieve.",
+		}, "\n\n"),
+		RecordSchema: volumesSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all volumes will be from the provided region",
+			},
+		},
+		ResultAttributeName: "volumes",
+		FlattenRecord:       flattenDataSourceVolumes,
+		GetRecords:          getDataSourceVolumes,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceVolumes(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	// overwrite the region if is define in the datasource
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	apiClient := utils.ClientForRegion(m, region)
+
+	partialVolumes, err := apiClient.ListVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving volumes: %s", err)
+	}
+
+	var volumes []interface{}
+	for _, partialVolume := range partialVolumes {
+		volumes = append(volumes, partialVolume)
+	}
+
+	return volumes, nil
+}
+
+func flattenDataSourceVolumes(volume, m interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+	// the volumes are listed in the region of the provider when the data source doesn't declare one
+	region = utils.ClientForRegion(m, region).Region
+
+	v := volume.(civogo.Volume)
+
+	flattenedVolume := map[string]interface{}{}
+	flattenedVolume["id"] = v.ID
+	flattenedVolume["name"] = v.Name
+	flattenedVolume["size_gb"] = v.SizeGigabytes
+	flattenedVolume["network_id"] = v.NetworkID
+	flattenedVolume["instance_id"] = v.InstanceID
+	flattenedVolume["cluster_id"] = v.ClusterID
+	flattenedVolume["attached"] = v.InstanceID != ""
+	flattenedVolume["status"] = v.Status
+	flattenedVolume["mount_point"] = v.MountPoint
+	flattenedVolume["bootable"] = v.Bootable
+	flattenedVolume["region"] = region
+	flattenedVolume["created_at"] = v.CreatedAt.UTC().String()
+
+	return flattenedVolume, nil
+}
+
+func volumesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the volume",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the volume",
+		},
+		"size_gb": {
+			Type:        schema.TypeInt,
+			Description: "The size of the volume (in GB)",
+		},
+		"network_id": {
+			Type:        schema.TypeString,
+			Description: "The network of the volume",
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the instance the volume is attached to, empty when the volume isn't attached",
+		},
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the Kubernetes cluster of the volume, empty when the volume isn't a volume of a cluster",
+		},
+		"attached": {
+			Type:        schema.TypeBool,
+			Description: "Whether the volume is attached to an instance",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "The status of the volume, e.g. available or attached",
+		},
+		"mount_point": {
+			Type:        schema.TypeString,
+			Description: "The mount point of the volume",
+		},
+		"bootable": {
+			Type:        schema.TypeBool,
+			Description: "Whether the volume is bootable",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "The region of the volume",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "The date of the creation of the volume",
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package volume_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoVolumes_basic(t *testing.T) {
+	datasourceName := "data.civo_volumes.foobar"
+	name := acctest.RandomWithPrefix("ds-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoVolumesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "volumes.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "volumes.0.name", name),
+					resource.TestCheckResourceAttr(datasourceName, "volumes.0.size_gb", "10"),
+					resource.TestCheckResourceAttr(datasourceName, "volumes.0.attached", "false"),
+					resource.TestCheckResourceAttr(datasourceName, "volumes.0.region", "LON1"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoVolumesConfig(name string) string {
+	return fmt.Sprintf(`
+data "civo_network" "default" {
+	label = "default"
+	region = "LON1"
+}
+
+resource "civo_volume" "newvolume" {
+	name = "%s"
+	size_gb = 10
+	network_id = data.civo_network.default.id
+	region = "LON1"
+}
+
+data "civo_volumes" "foobar" {
+	region = "LON1"
+	filter {
+		key = "name"
+		values = [civo_volume.newvolume.name]
+	}
+	filter {
+		key = "attached"
+		values = ["false"]
+	}
+}
+`, name)
+}
@@ -0,0 +1,100 @@
+package volume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// detachVolume detaches the volume and waits until it's detached. The detach is sent again with exponential
+// backoff while the API fails with a transient error, until the timeout. A volume that is already detached or
+// deleted is not an error.
+func detachVolume(ctx context.Context, apiClient *civogo.Client, config utils.RetryConfig, volumeID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		tflog.Info(ctx, fmt.Sprintf("detaching the volume %s", volumeID))
+		_, err := apiClient.DetachVolume(volumeID)
+		if err == nil {
+			break
+		}
+		if utils.IsNotFound(err) || errors.Is(err, civogo.DatabaseVolumeNotAttachedError) {
+			return nil
+		}
+
+		wait := config.Backoff(attempt)
+		if !utils.IsRetryableError(err) || time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("[ERR] an error occurred while trying to detach the volume %s: %s", volumeID, err)
+		}
+
+		tflog.Warn(ctx, "detaching the volume failed with a retryable error, retrying", map[string]interface{}{
+			"volume_id": volumeID,
+			"wait":      wait.String(),
+			"attempt":   attempt + 1,
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	// the volume can be attached to another instance or deleted once it's detached
+	return waitForVolume(ctx, apiClient, volumeID, "detaching", "detached", volumeDetachedState, time.Until(deadline))
+}
+
+// forceDetachVolume shuts the instance down so it releases the volume, detaches the volume and starts the
+// instance again. It's the last resort when the volume can't be detached while the instance is running.
+func forceDetachVolume(ctx context.Context, apiClient *civogo.Client, config utils.RetryConfig, volumeID, instanceID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	tflog.Warn(ctx, fmt.Sprintf("stopping the instance %s to force the detach of the volume %s", instanceID, volumeID))
+	if _, err := apiClient.StopInstance(instanceID); err != nil {
+		return fmt.Errorf("[ERR] an error occurred while stopping the instance %s to detach the volume %s: %s", instanceID, volumeID, err)
+	}
+	err := waitForInstanceStatus(ctx, apiClient, instanceID, []string{"ACTIVE", "STOPPING", "SHUTTING_DOWN"}, "SHUTOFF", time.Until(deadline))
+	if err != nil {
+		return err
+	}
+
+	if err := detachVolume(ctx, apiClient, config, volumeID, time.Until(deadline)); err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("starting the instance %s again", instanceID))
+	if _, err := apiClient.StartInstance(instanceID); err != nil {
+		return fmt.Errorf("[ERR] the volume %s was detached but an error occurred while starting the instance %s again: %s", volumeID, instanceID, err)
+	}
+
+	return waitForInstanceStatus(ctx, apiClient, instanceID, []string{"SHUTOFF", "STARTING", "BUILDING"}, "ACTIVE", time.Until(deadline))
+}
+
+// waitForInstanceStatus waits until the instance has the target status
+func waitForInstanceStatus(ctx context.Context, apiClient *civogo.Client, instanceID string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(instanceID)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to be %s: %s", instanceID, target, err)
+	}
+
+	return nil
+}
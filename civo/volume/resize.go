@@ -0,0 +1,74 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// volumeSizeCustomizeDiff fails when the volume would shrink, as volumes can only grow and replacing the volume
+// would lose its data
+func volumeSizeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("size_gb") {
+		return nil
+	}
+
+	oldSize, newSize := d.GetChange("size_gb")
+	if newSize.(int) < oldSize.(int) {
+		return fmt.Errorf("[ERR] the volume can't be shrunk from %d GB to %d GB, only growing it is supported. Replace the volume to use a smaller size, which loses its data", oldSize.(int), newSize.(int))
+	}
+
+	return nil
+}
+
+// resizeVolume grows the volume and waits until it has the new size. A volume attached to an instance is
+// detached while it's resized and then attached back to the instance.
+func resizeVolume(ctx context.Context, apiClient *civogo.Client, volumeID string, size int, timeout time.Duration) error {
+	volume, err := apiClient.GetVolume(volumeID)
+	if err != nil {
+		return fmt.Errorf("[ERR] failed retrieving the volume: %s", err)
+	}
+	instanceID := volume.InstanceID
+
+	if instanceID != "" {
+		tflog.Info(ctx, fmt.Sprintf("detaching the volume %s from the instance %s to resize it", volumeID, instanceID))
+		if _, err := apiClient.DetachVolume(volumeID); err != nil {
+			return fmt.Errorf("[ERR] an error occurred while trying to detach the volume %s: %s", volumeID, err)
+		}
+		err := waitForVolume(ctx, apiClient, volumeID, "detaching", "detached", volumeDetachedState, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("resizing the volume %s to %d GB", volumeID, size))
+	if _, err := apiClient.ResizeVolume(volumeID, size); err != nil {
+		return fmt.Errorf("[ERR] the volume (%s) size not change %s", volumeID, err)
+	}
+	err = waitForVolume(ctx, apiClient, volumeID, "resizing", "resized", func(v *civogo.Volume) string {
+		if v.SizeGigabytes >= size && v.Status == "available" {
+			return "resized"
+		}
+		return "resizing"
+	}, timeout)
+	if err != nil {
+		return err
+	}
+
+	if instanceID != "" {
+		tflog.Info(ctx, fmt.Sprintf("attaching the volume %s back to the instance %s", volumeID, instanceID))
+		if _, err := apiClient.AttachVolume(volumeID, instanceID); err != nil {
+			return fmt.Errorf("[ERR] an error occurred while trying to attach the volume %s back to the instance %s: %s", volumeID, instanceID, err)
+		}
+		err := waitForVolume(ctx, apiClient, volumeID, "attaching", "attached", volumeAttachedState(instanceID), timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
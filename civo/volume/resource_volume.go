@@ -3,14 +3,15 @@ package volume
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // ResourceVolume function returns a schema.Resource that represents a Volume.
@@ -26,9 +27,10 @@ func ResourceVolume() *schema.Resource {
 				ValidateFunc: utils.ValidateName,
 			},
 			"size_gb": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "A minimum of 1 and a maximum of your available disk space from your quota specifies the size of the volume in gigabytes ",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "A minimum of 1 and a maximum of your available disk space from your quota specifies the size of the volume in gigabytes. The volume is resized in place when the size grows, a volume attached to an instance is detached while it's resized and attached back after. The volume can't be shrunk",
 			},
 			"region": {
 				Type:        schema.TypeString,
@@ -40,38 +42,49 @@ func ResourceVolume() *schema.Resource {
 				Required:    true,
 				Description: "The network that the volume belongs to",
 			},
+			"bootable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the volume can be used to boot an instance, it can only be set when the volume is created",
+			},
 			// Computed resource
 			"mount_point": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The mount point of the volume (from instance's perspective)",
 			},
+			"deletion_protection": utils.DeletionProtectionSchema(),
 		},
 		CreateContext: resourceVolumeCreate,
 		ReadContext:   resourceVolumeRead,
 		UpdateContext: resourceVolumeUpdate,
 		DeleteContext: resourceVolumeDelete,
+		CustomizeDiff: volumeSizeCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceVolumeImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
 // function to create the new volume
 func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ResourceClient(m, d)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
-	log.Printf("[INFO] configuring the volume %s", d.Get("name").(string))
+	tflog.Info(ctx, fmt.Sprintf("configuring the volume %s", d.Get("name").(string)))
 	config := &civogo.VolumeConfig{
 		Name:          d.Get("name").(string),
 		SizeGigabytes: d.Get("size_gb").(int),
 		NetworkID:     d.Get("network_id").(string),
 		Region:        apiClient.Region,
+		Bootable:      d.Get("bootable").(bool),
 	}
 
 	_, err := apiClient.FindNetwork(config.NetworkID)
@@ -96,7 +109,7 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			}
 			return resp, resp.Status, nil
 		},
-		Timeout:        60 * time.Minute,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
 		Delay:          3 * time.Second,
 		MinTimeout:     3 * time.Second,
 		NotFoundChecks: 10,
@@ -110,20 +123,14 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 // function to read the volume
-func resourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	log.Printf("[INFO] retrieving the volume %s", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("retrieving the volume %s", d.Id()))
 	resp, err := apiClient.FindVolume(d.Id())
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "volume")
 		}
 		return diag.Errorf("[ERR] failed retrieving the volume: %s", err)
 	}
@@ -132,59 +139,20 @@ func resourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}
 	d.Set("network_id", resp.NetworkID)
 	d.Set("size_gb", resp.SizeGigabytes)
 	d.Set("mount_point", resp.MountPoint)
+	d.Set("bootable", resp.Bootable)
 
 	return nil
 }
 
 // function to update the volume
 func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
-	// apiClient := m.(*civogo.Client)
-
-	// // overwrite the region if is define in the datasource
-	// if region, ok := d.GetOk("region"); ok {
-	// 	apiClient.Region = region.(string)
-	// }
-
-	// log.Printf("[INFO] retrieving the volume %s", d.Id())
-	// resp, err := apiClient.FindVolume(d.Id())
-	// if err != nil {
-	// 	return fmt.Errorf("[ERR] failed retrieving the volume: %s", err)
-	// }
-
+	// the volume can only grow, shrinking it fails when planning
 	if d.HasChange("size_gb") {
-		return diag.Errorf("[ERR] Resize operation is not available at this moment - we are working to re-enable it soon")
-
-		/*
-			if resp.InstanceID != "" {
-				_, err := apiClient.DetachVolume(d.Id())
-				if err != nil {
-					return fmt.Errorf("[WARN] an error occurred while trying to detach volume %s, %s", d.Id(), err)
-				}
-
-				time.Sleep(10 * time.Second)
-
-				newSize := d.Get("size_gb").(int)
-				_, err = apiClient.ResizeVolume(d.Id(), newSize)
-				if err != nil {
-					return fmt.Errorf("[ERR] the volume (%s) size not change %s", d.Id(), err)
-				}
-
-				time.Sleep(2 * time.Second)
-
-				_, err = apiClient.AttachVolume(d.Id(), resp.InstanceID)
-				if err != nil {
-					return fmt.Errorf("[ERR] an error occurred while trying to attach the volume %s", d.Id())
-				}
-
-			} else {
-				newSize := d.Get("size_gb").(int)
-				_, err = apiClient.ResizeVolume(d.Id(), newSize)
-				if err != nil {
-					return fmt.Errorf("[ERR] the volume (%s) size not change %s", d.Id(), err)
-				}
-			}
-		*/
+		if err := resizeVolume(ctx, apiClient, d.Id(), d.Get("size_gb").(int), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if d.HasChange("network_id") {
@@ -199,15 +167,14 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 // function to delete the volume
-func resourceVolumeDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if diags := utils.CheckDeletionProtection(d, "volume"); diags != nil {
+		return diags
 	}
 
-	log.Printf("[INFO] deleting the volume %s", d.Id())
+	apiClient := utils.ResourceClient(m, d)
+
+	tflog.Info(ctx, fmt.Sprintf("deleting the volume %s", d.Id()))
 	_, err := apiClient.DeleteVolume(d.Id())
 	if err != nil {
 		return diag.Errorf("[ERR] an error occurred while trying to delete the volume %s", err)
@@ -217,8 +184,7 @@ func resourceVolumeDelete(_ context.Context, d *schema.ResourceData, m interface
 
 // custom import to able to import a volume
 func resourceVolumeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
-	regions, err := apiClient.ListRegions()
+	regions, err := utils.ClientForRegion(m, "").ListRegions()
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +196,7 @@ func resourceVolumeImport(d *schema.ResourceData, m interface{}) ([]*schema.Reso
 		}
 
 		currentRegion := region.Code
-		apiClient.Region = currentRegion
+		apiClient := utils.ClientForRegion(m, currentRegion)
 
 		volumes, err := apiClient.ListVolumes()
 		if err != nil {
@@ -246,6 +212,7 @@ func resourceVolumeImport(d *schema.ResourceData, m interface{}) ([]*schema.Reso
 				d.Set("region", currentRegion)
 				d.Set("size_gb", volume.SizeGigabytes)
 				d.Set("mount_point", volume.MountPoint)
+				d.Set("bootable", volume.Bootable)
 			}
 		}
 	}
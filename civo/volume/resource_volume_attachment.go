@@ -3,10 +3,10 @@ package volume
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
-	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,33 +39,45 @@ func ResourceVolumeAttachment() *schema.Resource {
 				ForceNew:    true,
 				Description: "The region for the volume attachment",
 			},
+			"force_detach": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Shut the instance down to release the volume when it can't be detached while the instance is running, then start the instance again. The detach is first retried for half of the delete timeout. It can be changed in place and it has to be applied before the attachment is destroyed",
+			},
+			// Computed resource
+			"mount_point": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The device path of the volume in the instance (e.g. /dev/vdb), known once the volume is attached so it can be used in the scripts mounting the volume",
+			},
 		},
 		CreateContext: resourceVolumeAttachmentCreate,
 		ReadContext:   resourceVolumeAttachmentRead,
+		UpdateContext: resourceVolumeAttachmentUpdate,
 		DeleteContext: resourceVolumeAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
 // function to create the new volume
 func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ResourceClient(m, d)
 
 	instanceID := d.Get("instance_id").(string)
 	volumeID := d.Get("volume_id").(string)
 
-	log.Printf("[INFO] retrieving the volume %s", volumeID)
+	tflog.Info(ctx, fmt.Sprintf("retrieving the volume %s", volumeID))
 	volume, err := apiClient.FindVolume(volumeID)
 	if err != nil {
 		return diag.Errorf("[ERR] Error retrieving volume: %s", err)
 	}
 
 	if volume.InstanceID == "" || volume.InstanceID != instanceID {
-		log.Printf("[INFO] attaching the volume %s to instance %s", volumeID, instanceID)
+		tflog.Info(ctx, fmt.Sprintf("attaching the volume %s to instance %s", volumeID, instanceID))
 		_, err := apiClient.AttachVolume(volumeID, instanceID)
 		if err != nil {
 			return diag.Errorf("[ERR] error attaching volume to instance %s", err)
@@ -74,75 +86,77 @@ func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-%s-", instanceID, volumeID)))
 
-	createStateConf := &resource.StateChangeConf{
-		Pending: []string{"attaching"},
-		Target:  []string{"attached"},
-		Refresh: func() (interface{}, string, error) {
-			resp, err := apiClient.FindVolume(volumeID)
-			if err != nil {
-				return 0, "", err
-			}
-			return resp, resp.Status, nil
-		},
-		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
-		NotFoundChecks: 10,
-	}
-	_, err = createStateConf.WaitForStateContext(context.Background())
+	// the device path of the volume is only known once the volume is attached
+	err = waitForVolume(ctx, apiClient, volumeID, "attaching", "attached", volumeAttachedState(instanceID), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.Errorf("error waiting for volume (%s) to be attached: %s", d.Id(), err)
+		return diag.FromErr(err)
 	}
 
 	return resourceVolumeAttachmentRead(ctx, d, m)
 }
 
 // function to read the volume
-func resourceVolumeAttachmentRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
 
 	instanceID := d.Get("instance_id").(string)
 	volumeID := d.Get("volume_id").(string)
 
-	log.Printf("[INFO] retrieving the volume %s", volumeID)
+	tflog.Info(ctx, fmt.Sprintf("retrieving the volume %s", volumeID))
 	resp, err := apiClient.FindVolume(volumeID)
 	if err != nil {
-		if resp == nil {
-			d.SetId("")
-			return nil
+		if utils.IsNotFound(err) {
+			return utils.RemoveFromState(ctx, d, "volume attachment")
 		}
 
 		return diag.Errorf("[ERR] failed retrieving the volume: %s", err)
 	}
 
+	// the volume is attached again on the next apply when it's no longer attached to the instance
 	if resp.InstanceID == "" || resp.InstanceID != instanceID {
-		log.Printf("[DEBUG] Volume Attachment (%s) not found, removing from state", d.Id())
+		tflog.Debug(ctx, fmt.Sprintf("Volume Attachment (%s) not found, removing from state", d.Id()))
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("mount_point", resp.MountPoint)
+
 	return nil
 }
 
+// function to update the volume attachment, only `force_detach` can change and it's only used on delete
+func resourceVolumeAttachmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceVolumeAttachmentRead(ctx, d, m)
+}
+
 // function to delete the volume
-func resourceVolumeAttachmentDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ResourceClient(m, d)
+	retryConfig := m.(*utils.ProviderMeta).Retry
+
+	instanceID := d.Get("instance_id").(string)
+	volumeID := d.Get("volume_id").(string)
+	forceDetach := d.Get("force_detach").(bool)
 
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	timeout := d.Timeout(schema.TimeoutDelete)
+	deadline := time.Now().Add(timeout)
+	// half of the timeout is kept to force the detach
+	if forceDetach {
+		timeout /= 2
 	}
 
-	volumeID := d.Get("volume_id").(string)
+	err := detachVolume(ctx, apiClient, retryConfig, volumeID, timeout)
+	if err == nil {
+		return nil
+	}
+	if !forceDetach {
+		return diag.FromErr(err)
+	}
 
-	log.Printf("[INFO] Detaching the volume %s", d.Id())
-	_, err := apiClient.DetachVolume(volumeID)
-	if err != nil {
-		return diag.Errorf("[ERR] an error occurred while trying to detach the volume %s", err)
+	tflog.Warn(ctx, fmt.Sprintf("the volume %s couldn't be detached, forcing the detach: %s", volumeID, err))
+	if err := forceDetachVolume(ctx, apiClient, retryConfig, volumeID, instanceID, time.Until(deadline)); err != nil {
+		return diag.FromErr(err)
 	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package volume_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/civo/civogo"
@@ -37,6 +38,8 @@ func TestAccCivoVolumeAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "id"),
 					resource.TestCheckResourceAttrSet(resName, "instance_id"),
 					resource.TestCheckResourceAttrSet(resName, "volume_id"),
+					resource.TestMatchResourceAttr(resName, "mount_point", regexp.MustCompile(`^/dev/`)),
+					resource.TestCheckResourceAttr(resName, "force_detach", "false"),
 				),
 			},
 		},
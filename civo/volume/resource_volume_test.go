@@ -2,10 +2,12 @@ package volume_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -36,12 +38,62 @@ func TestAccCivoVolume_basic(t *testing.T) {
 					// verify local values
 					resource.TestCheckResourceAttr(resName, "name", VolumeName),
 					resource.TestCheckResourceAttr(resName, "size_gb", "10"),
+					resource.TestCheckResourceAttr(resName, "bootable", "false"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccCivoVolume_resize(t *testing.T) {
+	var volume civogo.Volume
+	var volumeID string
+
+	// generate a random name for each test run
+	resName := "civo_volume.foobar"
+	var VolumeName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoVolumeConfigSize(VolumeName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					CivoVolumeResourceExists(resName, &volume),
+					func(_ *terraform.State) error {
+						volumeID = volume.ID
+						return nil
+					},
+				),
+			},
+			{
+				// the volume is resized in place, it keeps its ID
+				Config: CivoVolumeConfigSize(VolumeName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					CivoVolumeResourceExists(resName, &volume),
+					func(_ *terraform.State) error {
+						if volume.ID != volumeID {
+							return fmt.Errorf("the volume was replaced, expected the ID \"%s\", got: %#v", volumeID, volume.ID)
+						}
+						if volume.SizeGigabytes != 20 {
+							return fmt.Errorf("bad size, expected 20, got: %d", volume.SizeGigabytes)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resName, "size_gb", "20"),
+				),
+			},
+			{
+				Config:      CivoVolumeConfigSize(VolumeName, 10),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the volume can't be shrunk"),
+			},
+		},
+	})
+}
+
 func CivoVolumeValues(volume *civogo.Volume, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if volume.Name != name {
@@ -61,7 +113,7 @@ func CivoVolumeResourceExists(n string, volume *civogo.Volume) resource.TestChec
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 		resp, err := client.FindVolume(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Volume not found: (%s) %s", rs.Primary.ID, err)
@@ -76,7 +128,7 @@ func CivoVolumeResourceExists(n string, volume *civogo.Volume) resource.TestChec
 }
 
 func CivoVolumeDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := utils.ProviderClient(acceptance.TestAccProvider.Meta())
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_volume" {
@@ -106,3 +158,18 @@ resource "civo_volume" "foobar" {
 	region = "LON1"
 }`, name)
 }
+
+func CivoVolumeConfigSize(name string, size int) string {
+	return fmt.Sprintf(`
+data "civo_network" "default" {
+	label = "default"
+	region = "LON1"
+}
+
+resource "civo_volume" "foobar" {
+	name = "%s"
+	size_gb = %d
+	network_id = data.civo_network.default.id
+	region = "LON1"
+}`, name, size)
+}
@@ -0,0 +1,53 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// waitForVolume waits until the volume is in the target state, the state being computed from the volume by the
+// state function
+func waitForVolume(ctx context.Context, apiClient *civogo.Client, volumeID, pending, target string, state func(*civogo.Volume) string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{pending},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := apiClient.GetVolume(volumeID)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, state(resp), nil
+		},
+		Timeout:        timeout,
+		Delay:          3 * time.Second,
+		MinTimeout:     3 * time.Second,
+		NotFoundChecks: 10,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("[ERR] error waiting for volume (%s) to be %s: %s", volumeID, target, err)
+	}
+
+	return nil
+}
+
+// volumeAttachedState is the state of the volume when waiting for it to be attached to the instance
+func volumeAttachedState(instanceID string) func(*civogo.Volume) string {
+	return func(v *civogo.Volume) string {
+		if v.InstanceID == instanceID && v.Status == "attached" {
+			return "attached"
+		}
+		return "attaching"
+	}
+}
+
+// volumeDetachedState is the state of the volume when waiting for it to be detached
+func volumeDetachedState(v *civogo.Volume) string {
+	if v.InstanceID == "" && v.Status == "available" {
+		return "detached"
+	}
+	return "detaching"
+}
@@ -0,0 +1,24 @@
+# Every Ubuntu disk image of the region, the most recent version first
+data "civo_disk_images" "ubuntu" {
+    filter {
+        key = "distribution"
+        values = ["ubuntu"]
+    }
+
+    filter {
+        key = "name"
+        values = ["^ubuntu-(jammy|noble)"]
+        match_by = "re"
+    }
+
+    sort {
+        key = "version"
+        direction = "desc"
+    }
+}
+
+resource "civo_instance" "my-test-instance" {
+    hostname = "foo.com"
+    size = "g3.small"
+    disk_image = element(data.civo_disk_images.ubuntu.disk_images, 0).name
+}
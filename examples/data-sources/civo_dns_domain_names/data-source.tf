@@ -0,0 +1,20 @@
+data "civo_dns_domain_names" "all" {
+    sort {
+        key = "name"
+    }
+}
+
+# The TXT records of every domain, to check they have SPF and DMARC records
+data "civo_dns_domain_records" "txt" {
+    for_each = { for d in data.civo_dns_domain_names.all.domains : d.name => d.id }
+
+    domain_id = each.value
+    filter {
+        key = "type"
+        values = ["TXT"]
+    }
+}
+
+output "domains_without_spf" {
+  value = [for name, records in data.civo_dns_domain_records.txt : name if length([for r in records.records : r if startswith(r.value, "v=spf1")]) == 0]
+}
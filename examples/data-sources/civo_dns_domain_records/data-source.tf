@@ -0,0 +1,20 @@
+data "civo_dns_domain_name" "domain" {
+    name = "domain.com"
+}
+
+# The A records of the domain
+data "civo_dns_domain_records" "a" {
+    domain_id = data.civo_dns_domain_name.domain.id
+    filter {
+        key = "type"
+        values = ["A"]
+    }
+
+    sort {
+        key = "name"
+    }
+}
+
+output "a_records" {
+  value = { for r in data.civo_dns_domain_records.a.records : r.name => r.value }
+}
@@ -2,3 +2,8 @@ data "civo_firewall" "test" {
     name = "test-firewall"
     region = "LON1"
 }
+
+# The ports opened to everyone by the firewall
+output "public_ports" {
+  value = [for r in data.civo_firewall.test.ingress_rule : r.port_range if contains(r.cidr, "0.0.0.0/0")]
+}
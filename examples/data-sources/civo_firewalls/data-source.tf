@@ -0,0 +1,11 @@
+data "civo_firewalls" "all" {
+    region = "LON1"
+}
+
+# The firewalls opening SSH to everyone
+output "open_ssh_firewalls" {
+  value = [
+    for f in data.civo_firewalls.all.firewalls : f.name
+    if length([for r in f.ingress_rule : r if r.port_range == "22" && contains(r.cidr, "0.0.0.0/0")]) > 0
+  ]
+}
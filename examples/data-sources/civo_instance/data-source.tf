@@ -5,3 +5,7 @@ data "civo_instance" "myhostaname" {
 output "instance_output" {
   value = data.civo_instance.myhostaname.public_ip
 }
+
+data "civo_instance" "database" {
+    tag = "role=database"
+}
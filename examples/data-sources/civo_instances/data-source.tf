@@ -2,6 +2,18 @@ data "civo_instances" "small-size" {
     region = "LON1"
     filter {
         key = "size"
-        values = [g3.small]
+        values = ["g3.small"]
+    }
+}
+
+# Every instance tagged role=web, sorted by hostname
+data "civo_instances" "web" {
+    filter {
+        key = "tags"
+        values = ["role=web"]
+    }
+
+    sort {
+        key = "hostname"
     }
 }
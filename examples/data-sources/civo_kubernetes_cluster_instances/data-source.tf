@@ -0,0 +1,16 @@
+# The nodes of the default pool of a cluster
+data "civo_kubernetes_cluster_instances" "nodes" {
+    cluster_id = civo_kubernetes_cluster.my-cluster.id
+    filter {
+        key = "pool_id"
+        values = [civo_kubernetes_cluster.my-cluster.pools[0].label]
+    }
+
+    sort {
+        key = "hostname"
+    }
+}
+
+output "node_private_ips" {
+  value = { for n in data.civo_kubernetes_cluster_instances.nodes.instances : n.hostname => n.private_ip }
+}
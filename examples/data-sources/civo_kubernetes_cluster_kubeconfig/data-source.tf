@@ -0,0 +1,10 @@
+data "civo_kubernetes_cluster_kubeconfig" "my-cluster" {
+  cluster_id = civo_kubernetes_cluster.my-cluster.id
+}
+
+provider "kubernetes" {
+  host                   = data.civo_kubernetes_cluster_kubeconfig.my-cluster.host
+  client_certificate     = data.civo_kubernetes_cluster_kubeconfig.my-cluster.client_certificate
+  client_key             = data.civo_kubernetes_cluster_kubeconfig.my-cluster.client_key
+  cluster_ca_certificate = data.civo_kubernetes_cluster_kubeconfig.my-cluster.cluster_ca_certificate
+}
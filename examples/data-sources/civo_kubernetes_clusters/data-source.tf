@@ -0,0 +1,16 @@
+# Every cluster of the region tagged team=platform
+data "civo_kubernetes_clusters" "platform" {
+    region = "LON1"
+    filter {
+        key = "tags"
+        values = ["team=platform"]
+    }
+
+    sort {
+        key = "name"
+    }
+}
+
+output "platform_clusters" {
+  value = { for c in data.civo_kubernetes_clusters.platform.clusters : c.name => c.api_endpoint }
+}
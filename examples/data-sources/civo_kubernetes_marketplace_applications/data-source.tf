@@ -0,0 +1,15 @@
+# The monitoring applications of the marketplace
+data "civo_kubernetes_marketplace_applications" "monitoring" {
+    filter {
+        key = "category"
+        values = ["monitoring"]
+    }
+
+    sort {
+        key = "name"
+    }
+}
+
+output "monitoring_applications" {
+  value = { for a in data.civo_kubernetes_marketplace_applications.monitoring.applications : a.name => a.plans }
+}
@@ -11,3 +11,23 @@ data "civo_kubernetes_version" "k3s" {
     values = ["k3s"]
   }
 }
+
+# The most recent stable 1.29 version
+data "civo_kubernetes_version" "stable" {
+  version_constraint = "~> 1.29.0"
+
+  filter {
+    key    = "type"
+    values = ["k3s"]
+  }
+}
+
+resource "civo_kubernetes_cluster" "my-cluster" {
+  name               = "my-cluster"
+  kubernetes_version = data.civo_kubernetes_version.stable.latest_stable
+  firewall_id        = civo_firewall.my-firewall.id
+  pools {
+    size       = "g4s.kube.medium"
+    node_count = 3
+  }
+}
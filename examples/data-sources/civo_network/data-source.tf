@@ -1,6 +1,10 @@
+# Look up a network by its label
 data "civo_network" "test" {
     label = "test-network"
     region = "LON1"
-    cidr_v4        = "10.0.0.0/24"
-    nameservers_v4 = ["8.8.8.8", "8.8.4.4", "1.1.1.1"]
+}
+
+# The default network of a region
+data "civo_network" "default" {
+    region = "LON1"
 }
@@ -0,0 +1,13 @@
+# The networks of the region whose label starts with shared-
+data "civo_networks" "shared" {
+    region = "LON1"
+    filter {
+        key = "label"
+        values = ["^shared-"]
+        match_by = "re"
+    }
+}
+
+output "shared_networks" {
+  value = { for n in data.civo_networks.shared.networks : n.label => n.id }
+}
@@ -0,0 +1,8 @@
+data "civo_reserved_ip" "www" {
+    name = "nginx-www"
+}
+
+# The same reserved IP looked up by its address
+data "civo_reserved_ip" "static" {
+    ip = "74.220.21.12"
+}
@@ -0,0 +1,21 @@
+# The volumes of the region that aren't attached to an instance
+data "civo_volumes" "unattached" {
+    region = "LON1"
+    filter {
+        key = "attached"
+        values = ["false"]
+    }
+
+    sort {
+        key = "name"
+    }
+}
+
+# Attach them again to the instance
+resource "civo_volume_attachment" "restore" {
+    for_each = { for v in data.civo_volumes.unattached.volumes : v.name => v.id }
+
+    instance_id = civo_instance.db.id
+    volume_id = each.value
+    region = "LON1"
+}
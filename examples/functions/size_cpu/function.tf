@@ -0,0 +1,7 @@
+locals {
+  node_size = "g4s.kube.medium"
+}
+
+output "node_cpu" {
+  value = provider::civo::size_cpu(local.node_size)
+}
@@ -0,0 +1,7 @@
+locals {
+  node_size = "g4s.kube.medium"
+}
+
+output "node_disk" {
+  value = provider::civo::size_disk(local.node_size)
+}
@@ -0,0 +1,7 @@
+locals {
+  node_size = "g4s.kube.medium"
+}
+
+output "node_ram" {
+  value = provider::civo::size_ram(local.node_size)
+}
@@ -1,2 +1,2 @@
-# using domain name
+# using domain name or ID
 terraform import civo_dns_domain_name.main mydomain.com
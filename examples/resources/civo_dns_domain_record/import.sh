@@ -1,2 +1,8 @@
 # using domain_id:domain_record_id
 terraform import civo_dns_domain_record.www a3cd6832-9577-4017-afd7-17d239fc0bf0:c9a39d14-ee1b-4870-8fb0-a2d4f465e822
+
+# using domain/type/name, the domain being its name or ID and @ being the apex
+terraform import civo_dns_domain_record.www mydomain.com/A/www
+
+# using domain/type/name/value, when several records have the same type and name
+terraform import civo_dns_domain_record.www mydomain.com/A/www/10.10.10.1
@@ -42,3 +42,15 @@ resource "civo_dns_domain_record" "www" {
     ttl = 600
     depends_on = [civo_dns_domain_name.mydomain, civo_instance.foo]
 }
+
+# Create an SRV record for a SIP service
+resource "civo_dns_domain_record" "sip" {
+    domain_id = civo_dns_domain_name.mydomain.id
+    type = "SRV"
+    name = "_sip._tcp"
+    value = "sip.mydomain.com"
+    priority = 10
+    weight = 5
+    port = 5060
+    ttl = 600
+}
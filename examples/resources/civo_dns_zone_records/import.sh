@@ -0,0 +1,2 @@
+# using the name or the ID of the domain
+terraform import civo_dns_zone_records.mydomain mydomain.com
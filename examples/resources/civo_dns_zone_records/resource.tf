@@ -0,0 +1,33 @@
+# Create a new domain name
+resource "civo_dns_domain_name" "mydomain" {
+  name = "mydomain.com"
+}
+
+# Manage the records of the domain from a zone file
+resource "civo_dns_zone_records" "mydomain" {
+    domain_id = civo_dns_domain_name.mydomain.id
+    zone_file = file("${path.module}/mydomain.com.zone")
+}
+
+# Or manage them from record blocks
+resource "civo_dns_domain_name" "otherdomain" {
+  name = "otherdomain.com"
+}
+
+resource "civo_dns_zone_records" "otherdomain" {
+    domain_id = civo_dns_domain_name.otherdomain.id
+
+    record {
+        type = "A"
+        name = "www"
+        value = "10.10.10.1"
+    }
+
+    record {
+        type = "MX"
+        name = "@"
+        value = "mx.otherdomain.com"
+        priority = 10
+        ttl = 3600
+    }
+}
@@ -45,3 +45,16 @@ resource "civo_firewall" "www" {
     action     = "allow"
   }
 }
+
+# Create a firewall opening HTTPS over IPv4 and IPv6
+resource "civo_firewall" "dual_stack" {
+  name       = "dual-stack"
+  network_id = civo_network.custom_net.id
+  ingress_rule {
+    label      = "https"
+    protocol   = "tcp"
+    port_range = "443"
+    cidr       = ["0.0.0.0/0", "::/0"]
+    action     = "allow"
+  }
+}
@@ -0,0 +1,2 @@
+# using the ID, name or address of the reserved ip
+terraform import civo_instance_reserved_ip_assignment.webserver-www nginx-www
@@ -0,0 +1,5 @@
+# using cluster_id:application_name
+terraform import civo_kubernetes_application.prometheus 1b8b2100-0e9f-4e8f-ad78-9eb578c2a0af:prometheus-operator
+
+# using cluster_name:application_name
+terraform import civo_kubernetes_application.prometheus my-cluster:prometheus-operator
@@ -0,0 +1,12 @@
+# Install an application in an existing cluster
+resource "civo_kubernetes_application" "prometheus" {
+  cluster_id = civo_kubernetes_cluster.my-cluster.id
+  name       = "prometheus-operator"
+}
+
+# Install an application with a plan
+resource "civo_kubernetes_application" "mariadb" {
+  cluster_id = civo_kubernetes_cluster.my-cluster.id
+  name       = "MariaDB"
+  plan       = "5GB"
+}
@@ -54,10 +54,9 @@ resource "civo_kubernetes_cluster" "my-cluster" {
 }
 
 
-# Create a cluster with talos
+# Create a cluster with talos, talos clusters don't support marketplace applications
 resource "civo_kubernetes_cluster" "my-cluster" {
     name = "my-cluster"
-    applications = "Portainer,Linkerd:Linkerd & Jaeger"
     firewall_id = civo_firewall.my-firewall.id
     cluster_type = "talos"
     pools {
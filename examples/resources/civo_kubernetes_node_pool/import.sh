@@ -1,2 +1,5 @@
 # using cluster_id:node_pool_id
 terraform import civo_kubernetes_node_pool.my-pool 1b8b2100-0e9f-4e8f-ad78-9eb578c2a0af:502c1130-cb9b-4a88-b6d2-307bd96d946a
+
+# using cluster_name:node_pool_id
+terraform import civo_kubernetes_node_pool.my-pool my-cluster:back-end
@@ -30,3 +30,13 @@ resource "civo_kubernetes_node_pool" "back-end" {
    size = element(data.civo_size.xsmall.sizes, 0).name // Optional
    region = "LON1"
 }
+
+# Add a node pool whose nodes have public IPs, next to the private back-end pool
+resource "civo_kubernetes_node_pool" "front-end" {
+   cluster_id = civo_kubernetes_cluster.my-cluster.id
+   label = "front-end" // Optional
+   node_count = 1 // Optional
+   size = element(data.civo_size.xsmall.sizes, 0).name // Optional
+   public_ip_node_pool = true // Optional
+   region = "LON1"
+}
@@ -0,0 +1,9 @@
+# Recycle the nodes of the default pool one at a time every time the patch version changes
+resource "civo_kubernetes_node_recycle" "os-patch" {
+  cluster_id   = civo_kubernetes_cluster.my-cluster.id
+  node_pool_id = civo_kubernetes_cluster.my-cluster.pools.0.label
+
+  triggers = {
+    patch = "2024-02"
+  }
+}
@@ -1,3 +1,23 @@
 resource "civo_network" "custom_net" {
     label = "test_network"
 }
+
+# A network with its own CIDR and nameservers
+resource "civo_network" "backend" {
+    label = "backend"
+    cidr_v4 = "10.20.0.0/16"
+    nameservers_v4 = ["8.8.8.8", "1.1.1.1"]
+}
+
+# A network connected to a VLAN of a CivoStack region
+resource "civo_network" "hybrid" {
+    label = "hybrid"
+    vlan {
+        vlan_id = 100
+        physical_interface = "eth1"
+        cidr_v4 = "192.168.100.0/24"
+        gateway_ip_v4 = "192.168.100.1"
+        allocation_pool_v4_start = "192.168.100.10"
+        allocation_pool_v4_end = "192.168.100.200"
+    }
+}
@@ -1,3 +1,17 @@
 resource "civo_reserved_ip" "www" {
     name = "nginx-www" 
-}
\ No newline at end of file
+}
+
+resource "civo_dns_domain_name" "main" {
+    name = "mydomain.com"
+}
+
+# The address of the reserved IP can be used in a DNS record, it doesn't change
+# when the instance it's assigned to is replaced
+resource "civo_dns_domain_record" "www" {
+    domain_id = civo_dns_domain_name.main.id
+    type      = "A"
+    name      = "www"
+    value     = civo_reserved_ip.www.ip
+    ttl       = 600
+}
@@ -0,0 +1,2 @@
+# using ID of the instance
+terraform import civo_reverse_dns.www b8ecd2ab-2267-4a5e-8692-cbf1d32583e3
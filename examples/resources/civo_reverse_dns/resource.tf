@@ -0,0 +1,17 @@
+# Send to create a reserved IP
+resource "civo_reserved_ip" "www" {
+    name = "nginx-www"
+}
+
+# We assign the reserved IP to the instance
+resource "civo_instance_reserved_ip_assignment" "webserver-www" {
+  instance_id = civo_instance.www.id
+  reserved_ip_id = civo_reserved_ip.www.id
+}
+
+# Set the reverse DNS of the reserved IP
+resource "civo_reverse_dns" "www" {
+  ip = civo_reserved_ip.www.ip
+  reverse_dns = "www.example.com"
+  depends_on = [civo_instance_reserved_ip_assignment.webserver-www]
+}
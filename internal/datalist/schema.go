@@ -137,7 +137,8 @@ func computeFilterKeys(recordSchema map[string]*schema.Schema) []string {
 	var filterKeys []string
 
 	for key, schemaForKey := range recordSchema {
-		if schemaForKey.Type != schema.TypeMap {
+		// maps and nested blocks can't be filtered on
+		if _, nested := schemaForKey.Elem.(*schema.Resource); schemaForKey.Type != schema.TypeMap && !nested {
 			filterKeys = append(filterKeys, key)
 		}
 	}
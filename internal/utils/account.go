@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AccountSchema is the argument selecting which of the `api_keys` of the provider manages a resource
+func AccountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The name of the account in the `api_keys` of the provider used to manage the resource, if not set the `token` of the provider is used",
+	}
+}
+
+// DataSourceAccountSchema is the argument selecting which of the `api_keys` of the provider a data source reads from
+func DataSourceAccountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the account in the `api_keys` of the provider used to read the data source, if not set the `token` of the provider is used",
+	}
+}
+
+// ForAccount returns a copy of the provider meta whose client uses the API key of the given account,
+// the copy shares the cache, the semaphore and the rest of the settings of the provider
+func (m *ProviderMeta) ForAccount(account string) (*ProviderMeta, error) {
+	if account == "" {
+		return m, nil
+	}
+
+	client, ok := m.Accounts[account]
+	if !ok {
+		return nil, fmt.Errorf("[ERR] the account %q is not declared in the api_keys of the provider", account)
+	}
+
+	meta := *m
+	meta.Client = client
+	return &meta, nil
+}
+
+// WithAccount wraps the CRUD functions of a resource or data source so they use the client
+// of the account set in its `account` argument instead of the client of the provider
+func WithAccount(r *schema.Resource) {
+	wrapCRUD(r, func(_ string, fn crudFunc) crudFunc {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			meta, err := m.(*ProviderMeta).ForAccount(d.Get("account").(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			return fn(ctx, d, meta)
+		}
+	})
+}
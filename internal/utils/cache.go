@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache keeps the responses of the API that rarely change, like the sizes or the regions, for the time to live
+// configured in the provider, so the many data sources and resources of a plan don't fetch them again and again
+type Cache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	mu      sync.Mutex
+	value   interface{}
+	expires time.Time
+}
+
+// NewCache returns a cache keeping the values for the given time to live, a zero ttl disables the cache
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+func (c *Cache) entry(key string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &cacheEntry{}
+		c.entries[key] = e
+	}
+
+	return e
+}
+
+// Cached returns the value cached under the key, or calls fetch and caches its result if there is
+// no value or it has expired. Concurrent calls for the same key wait for a single fetch. Errors are
+// never cached.
+func Cached[T any](m interface{}, key string, fetch func() (T, error)) (T, error) {
+	cache := m.(*ProviderMeta).Cache
+	if cache == nil || cache.ttl <= 0 {
+		return fetch()
+	}
+
+	e := cache.entry(key)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if value, ok := e.value.(T); ok && time.Now().Before(e.expires) {
+		return value, nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	e.value = value
+	e.expires = time.Now().Add(cache.ttl)
+
+	return value, nil
+}
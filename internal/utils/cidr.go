@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+)
+
+// privateCIDRs are the private IPv4 address ranges of RFC 1918
+var privateCIDRs = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+// CIDRsOverlap reports whether the two networks share addresses
+func CIDRsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// ValidatePrivateCIDR is a function to check if the value is an IPv4 CIDR within the private ranges of RFC 1918
+func ValidatePrivateCIDR(v interface{}, k string) (ws []string, es []error) {
+	var errs []error
+	var warns []string
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected %s to be string", k))
+		return warns, errs
+	}
+
+	ip, cidr, err := net.ParseCIDR(value)
+	if err != nil || ip.To4() == nil {
+		errs = append(errs, fmt.Errorf("expected %s to be an IPv4 CIDR, got: %s", k, value))
+		return warns, errs
+	}
+
+	for _, private := range privateCIDRs {
+		_, privateCIDR, _ := net.ParseCIDR(private)
+		ones, _ := cidr.Mask.Size()
+		privateOnes, _ := privateCIDR.Mask.Size()
+		if privateCIDR.Contains(cidr.IP) && ones >= privateOnes {
+			return warns, errs
+		}
+	}
+
+	errs = append(errs, fmt.Errorf("expected %s to be within the private ranges of RFC 1918 (10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16), got: %s", k, value))
+	return warns, errs
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderMeta holds everything configured in the provider block, it is shared by all resources and data sources
+type ProviderMeta struct {
+	Client *civogo.Client
+	// Accounts are the clients of the `api_keys` of the provider by account name
+	Accounts    map[string]*civogo.Client
+	Retry       RetryConfig
+	DefaultTags []string
+	// Semaphore caps the operations running at the same time, it's nil when there is no limit
+	Semaphore chan struct{}
+	Cache     *Cache
+}
+
+// RetryConfig holds the retry settings used when the Civo API rate limits a request or fails with a transient error
+type RetryConfig struct {
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+// ProviderClient returns the civogo client configured in the provider
+func ProviderClient(m interface{}) *civogo.Client {
+	return m.(*ProviderMeta).Client
+}
+
+// ClientForRegion returns a copy of the civogo client configured in the provider bound to the given region.
+// If the region is empty the region declared in the provider is used. A copy is returned so concurrent
+// operations against different regions don't overwrite the region of the shared client.
+func ClientForRegion(m interface{}, region string) *civogo.Client {
+	client := *ProviderClient(m)
+	if region != "" {
+		client.Region = region
+	}
+
+	return &client
+}
+
+// ResourceClient returns the civogo client for a resource or data source, honoring the `region`
+// argument of the resource if it is set and falling back to the provider region otherwise
+func ResourceClient(m interface{}, d *schema.ResourceData) *civogo.Client {
+	if region, ok := d.GetOk("region"); ok {
+		return ClientForRegion(m, region.(string))
+	}
+
+	return ClientForRegion(m, "")
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withConcurrencyLimit wraps a CRUD function so it waits for a free slot in the semaphore of the provider before running
+func withConcurrencyLimit(fn crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		semaphore := m.(*ProviderMeta).Semaphore
+		if semaphore == nil {
+			return fn(ctx, d, m)
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "waiting for a free slot to run the operation", map[string]interface{}{
+				"max_concurrent_operations": cap(semaphore),
+			})
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return diag.Errorf("[ERR] timed out waiting for a free slot to run the operation: %s", ctx.Err())
+			}
+		}
+		defer func() { <-semaphore }()
+
+		return fn(ctx, d, m)
+	}
+}
+
+// LimitConcurrency wraps the CRUD functions of a resource or data source so no more than
+// `max_concurrent_operations` operations of the provider run at the same time. The slot is held for the
+// whole operation, the calls to the API can't be limited one by one as civogo doesn't let us set its transport
+func LimitConcurrency(r *schema.Resource) {
+	wrapCRUD(r, func(_ string, fn crudFunc) crudFunc {
+		return withConcurrencyLimit(fn)
+	})
+}
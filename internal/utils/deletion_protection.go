@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DeletionProtectionSchema is the flag that stops a resource from being deleted or replaced while it's set
+func DeletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, Terraform refuses to delete the resource, even when it needs to be replaced. Set it to false and apply before destroying the resource",
+	}
+}
+
+// CheckDeletionProtection returns an error diagnostic if the deletion protection of the resource is enabled
+func CheckDeletionProtection(d *schema.ResourceData, kind string) diag.Diagnostics {
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("[ERR] the %s %s has deletion_protection enabled, set it to false and apply before deleting it", kind, d.Id())
+	}
+
+	return nil
+}
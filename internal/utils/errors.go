@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	// civoErrorCode matches the name civogo gives to the errors it decodes, for example `DatabaseNotFoundError: ...`
+	civoErrorCode = regexp.MustCompile(`\b([A-Z][A-Za-z]*Error): `)
+	// civoHTTPStatus matches the status civogo includes in the message of errors it doesn't know about
+	civoHTTPStatus = regexp.MustCompile(`status: ([^,]+), code: (\d{3})\b`)
+	// civoAPICode matches the code field of the JSON body returned by the Civo API
+	civoAPICode = regexp.MustCompile(`"code"\s*:\s*"([^"]+)"`)
+	// civoRequestID matches the request or correlation ID when the Civo API returns it in the JSON body
+	civoRequestID = regexp.MustCompile(`"(?:request_id|requestId|correlation_id|correlationId|trace_id)"\s*:\s*"([^"]+)"`)
+)
+
+// apiErrorDetails returns the details of the Civo API error found in the message, one per line,
+// or an empty string if the message doesn't come from a civogo error
+func apiErrorDetails(msg string) string {
+	details := []string{}
+
+	if match := civoErrorCode.FindStringSubmatch(msg); match != nil {
+		details = append(details, fmt.Sprintf("Civo error: %s", match[1]))
+	}
+	if match := civoAPICode.FindStringSubmatch(msg); match != nil {
+		details = append(details, fmt.Sprintf("Civo API error code: %s", match[1]))
+	}
+	if match := civoHTTPStatus.FindStringSubmatch(msg); match != nil {
+		details = append(details, fmt.Sprintf("HTTP status: %s", strings.TrimSpace(match[1])))
+	}
+	if match := civoRequestID.FindStringSubmatch(msg); match != nil {
+		details = append(details, fmt.Sprintf("Civo request ID: %s", match[1]))
+	}
+
+	return strings.Join(details, "\n")
+}
+
+// annotateDiagnostics adds the Civo API error code, HTTP status and request ID found in the
+// message of every error diagnostic to its detail, so they can be given to Civo support
+func annotateDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	for i, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		details := apiErrorDetails(d.Summary + " " + d.Detail)
+		if details == "" || strings.Contains(d.Detail, details) {
+			continue
+		}
+
+		if d.Detail != "" {
+			details = d.Detail + "\n\n" + details
+		}
+		diags[i].Detail = details
+	}
+
+	return diags
+}
+
+// WithErrorDetails wraps the CRUD functions of a resource or data source so the error
+// diagnostics they return include the details of the Civo API error that caused them
+func WithErrorDetails(r *schema.Resource) {
+	wrapCRUD(r, func(_ string, fn crudFunc) crudFunc {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return annotateDiagnostics(fn(ctx, d, m))
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sensitiveLogFields are the fields whose values are never written to the logs
+var sensitiveLogFields = []string{"token", "api_key", "kubeconfig", "password", "secret_access_key", "initial_password"}
+
+// LoggingContext returns a context whose logs have the API tokens of the provider and the sensitive fields redacted
+func LoggingContext(ctx context.Context, m interface{}) context.Context {
+	if meta, ok := m.(*ProviderMeta); ok {
+		clients := []*civogo.Client{meta.Client}
+		for _, client := range meta.Accounts {
+			clients = append(clients, client)
+		}
+		for _, client := range clients {
+			if client != nil && client.APIKey != "" {
+				ctx = tflog.MaskMessageStrings(ctx, client.APIKey)
+				ctx = tflog.MaskAllFieldValuesStrings(ctx, client.APIKey)
+			}
+		}
+	}
+
+	return tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveLogFields...)
+}
+
+// withLogging wraps a CRUD function so its logs are redacted and its duration and outcome are logged at debug level
+func withLogging(name, operation string, fn crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx = LoggingContext(ctx, m)
+		ctx = tflog.SetField(ctx, "civo_resource", name)
+
+		start := time.Now()
+		diags := fn(ctx, d, m)
+		tflog.Debug(ctx, "civo operation finished", map[string]interface{}{
+			"operation":   operation,
+			"id":          d.Id(),
+			"duration_ms": time.Since(start).Milliseconds(),
+			"failed":      diags.HasError(),
+		})
+
+		return diags
+	}
+}
+
+// WithLogging wraps the CRUD functions of a resource or data source with withLogging
+func WithLogging(name string, r *schema.Resource) {
+	wrapCRUD(r, func(operation string, fn crudFunc) crudFunc {
+		return withLogging(name, operation, fn)
+	})
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// civoNotFound matches the errors civogo returns when the object doesn't exist anymore, like
+// `DatabaseInstanceNotFoundError: ...`, `DatabaseKubernetesClusterNotFound: ...` or an unknown error with a 404 status
+var civoNotFound = regexp.MustCompile(`\bDatabase[A-Za-z]*NotFound(Error)?\b|code: 404\b`)
+
+// IsNotFound reports whether the error returned by civogo means the object was not found,
+// for example because it was deleted outside of Terraform
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return errors.Is(err, civogo.ZeroMatchesError) || civoNotFound.MatchString(err.Error())
+}
+
+// RemoveFromState logs a warning and removes the resource from the state, so the next plan
+// proposes to create it again instead of failing because it was deleted outside of Terraform
+func RemoveFromState(ctx context.Context, d *schema.ResourceData, kind string) diag.Diagnostics {
+	tflog.Warn(ctx, fmt.Sprintf("the %s %s was not found, removing it from the state", kind, d.Id()))
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// retryableStatusCode matches the status code civogo includes in the message of errors it doesn't know about,
+// for example `Unknown error response - status: 429 Too Many Requests, code: 429, reason: ...`
+var retryableStatusCode = regexp.MustCompile(`code: (429|502|503|504)\b`)
+
+// isRetryableMessage reports whether an error message was caused by the Civo API rate limiting the
+// request or by a transient server or network problem, meaning the same request can be sent again
+func isRetryableMessage(msg string) bool {
+	return strings.Contains(msg, string(civogo.InternalServerError)) ||
+		strings.Contains(msg, string(civogo.TimeoutError)) ||
+		retryableStatusCode.MatchString(msg)
+}
+
+// IsRetryableError reports whether the error was caused by the Civo API rate limiting the request or by a
+// transient server or network problem, so resources can retry the calls that aren't reads
+func IsRetryableError(err error) bool {
+	return err != nil && isRetryableMessage(err.Error())
+}
+
+// rateLimitedStatusCode matches the status code of the errors of the requests rate limited by the Civo API
+var rateLimitedStatusCode = regexp.MustCompile(`code: 429\b`)
+
+// isRateLimitedMessage reports whether an error message was caused by the Civo API rate limiting the
+// request, meaning the API refused the request without changing anything
+func isRateLimitedMessage(msg string) bool {
+	return rateLimitedStatusCode.MatchString(msg)
+}
+
+// diagnosticsMatch reports whether every error in the diagnostics has a message matching the function
+func diagnosticsMatch(diags diag.Diagnostics, match func(msg string) bool) bool {
+	if !diags.HasError() {
+		return false
+	}
+
+	for _, d := range diags {
+		if d.Severity == diag.Error && !match(d.Summary+" "+d.Detail) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Backoff returns how long to wait before the given retry attempt (starting at zero), doubling
+// the minimum wait on every attempt without going over the maximum wait
+func (c RetryConfig) Backoff(attempt int) time.Duration {
+	wait := c.WaitMin
+	for i := 0; i < attempt && wait < c.WaitMax; i++ {
+		wait *= 2
+	}
+
+	if wait > c.WaitMax {
+		return c.WaitMax
+	}
+
+	return wait
+}
+
+// withRetry wraps a CRUD function so it is run again with exponential backoff while shouldRetry reports
+// the diagnostics it returned can be retried, up to the `max_retries` of the provider
+func withRetry(operation string, fn crudFunc, shouldRetry func(d *schema.ResourceData, diags diag.Diagnostics) bool) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		config := m.(*ProviderMeta).Retry
+
+		for attempt := 0; ; attempt++ {
+			diags := fn(ctx, d, m)
+			if !shouldRetry(d, diags) || attempt >= config.MaxRetries {
+				return diags
+			}
+
+			wait := config.Backoff(attempt)
+			tflog.Warn(ctx, operation+" failed with a retryable error, retrying", map[string]interface{}{
+				"id":          d.Id(),
+				"wait":        wait.String(),
+				"attempt":     attempt + 1,
+				"max_retries": config.MaxRetries,
+			})
+			select {
+			case <-ctx.Done():
+				return diags
+			case <-time.After(wait):
+			}
+		}
+	}
+}
+
+// RetryReadContext wraps a read function so it is run again with exponential backoff when it
+// fails because the Civo API rate limited a request or returned a transient error. Reads don't
+// change anything in the account, so the whole function can safely be retried.
+func RetryReadContext(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return withRetry("read", read, func(_ *schema.ResourceData, diags diag.Diagnostics) bool {
+		return diagnosticsMatch(diags, isRetryableMessage)
+	})
+}
+
+// RetryWriteContext wraps the create, update and delete functions of a resource so they are run
+// again with exponential backoff when they can safely be retried. The whole function is run again,
+// so it's only retried when the API rate limited a request, which the API refused without changing
+// anything. A transient error can come after a request that went through, e.g. an update creating a
+// node pool, so running the function again could repeat it:
+//   - updates and deletes are retried when a request was rate limited
+//   - creates are only retried when a request was rate limited and nothing was created yet, i.e. the
+//     ID of the resource isn't set, so a retry can't create the resource twice
+func RetryWriteContext(r *schema.Resource) {
+	wrapCRUD(r, func(operation string, fn crudFunc) crudFunc {
+		switch operation {
+		case "create":
+			return withRetry(operation, fn, func(d *schema.ResourceData, diags diag.Diagnostics) bool {
+				return d.Id() == "" && diagnosticsMatch(diags, isRateLimitedMessage)
+			})
+		case "update", "delete":
+			return withRetry(operation, fn, func(_ *schema.ResourceData, diags diag.Diagnostics) bool {
+				return diagnosticsMatch(diags, isRateLimitedMessage)
+			})
+		default:
+			return fn
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// GPUCountSchema is the computed attribute holding the number of GPUs of the size of a resource
+func GPUCountSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of GPUs of the size, 0 for sizes without GPUs",
+	}
+}
+
+// DiffClient returns the provider meta and the civogo client of the account and region of a resource being planned
+func DiffClient(m interface{}, d *schema.ResourceDiff) (*ProviderMeta, *civogo.Client, error) {
+	account, _ := d.Get("account").(string)
+	meta, err := m.(*ProviderMeta).ForAccount(account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	region, _ := d.Get("region").(string)
+	return meta, ClientForRegion(meta, region), nil
+}
+
+// FindSize returns the size with the given name from the cached sizes of the region of the client,
+// it's nil if the region doesn't offer the size
+func FindSize(m interface{}, apiClient *civogo.Client, name string) (*civogo.InstanceSize, error) {
+	sizes, err := Cached(m, "sizes/"+apiClient.Region, apiClient.ListInstanceSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, size := range sizes {
+		if size.Name == name {
+			return &size, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SizeCustomizeDiff checks when planning that the `size` of a new resource, or the new `size` of an existing
+// one, is available in its region and plans its `gpu_count`. The size of an existing resource isn't checked
+// again, so the resources on a size Civo has retired can still be planned. If the sizes can't be listed the
+// check is left to the Civo API.
+func SizeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && !d.HasChange("size") {
+		return nil
+	}
+	if !d.NewValueKnown("size") || !d.NewValueKnown("region") {
+		return d.SetNewComputed("gpu_count")
+	}
+
+	meta, apiClient, err := DiffClient(m, d)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("size").(string)
+	size, err := FindSize(meta, apiClient, name)
+	if err != nil {
+		tflog.Warn(ctx, "unable to list the sizes to validate the size", map[string]interface{}{
+			"size":  name,
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if size == nil {
+		return fmt.Errorf("[ERR] the size %q is not available in the region %s", name, apiClient.Region)
+	}
+
+	if d.Get("gpu_count").(int) != size.GPUCount {
+		return d.SetNew("gpu_count", size.GPUCount)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TagsAllSchema is the computed attribute holding the tags of a resource merged with the default tags of the provider
+func TagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All the tags of the resource, including the ones inherited from the `default_tags` of the provider",
+	}
+}
+
+// MergeDefaultTags returns the given tags together with the default tags of the provider, sorted and without duplicates
+func MergeDefaultTags(m interface{}, tags []string) []string {
+	seen := map[string]bool{}
+	merged := []string{}
+	for _, tag := range append(append([]string{}, tags...), m.(*ProviderMeta).DefaultTags...) {
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		merged = append(merged, tag)
+	}
+	sort.Strings(merged)
+
+	return merged
+}
+
+// RemoveDefaultTags returns the tags read from the API without the default tags of the provider,
+// unless they are also declared in the resource, so the default tags don't show up as a diff
+func RemoveDefaultTags(m interface{}, apiTags []string, configured []string) []string {
+	keep := map[string]bool{}
+	for _, tag := range configured {
+		keep[tag] = true
+	}
+	defaults := map[string]bool{}
+	for _, tag := range m.(*ProviderMeta).DefaultTags {
+		defaults[tag] = true
+	}
+
+	tags := []string{}
+	for _, tag := range apiTags {
+		if tag == "" || (defaults[tag] && !keep[tag]) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
+
+// SetToStrings converts a set of strings from the schema into a slice
+func SetToStrings(set *schema.Set) []string {
+	list := set.List()
+	values := make([]string, len(list))
+	for i, v := range list {
+		values[i] = v.(string)
+	}
+
+	return values
+}
+
+// TagsAllCustomizeDiff returns a CustomizeDiffFunc that plans `tags_all` as the tags of the resource,
+// returned by the tags function, merged with the default tags of the provider
+func TagsAllCustomizeDiff(tags func(d *schema.ResourceDiff) []string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		merged := MergeDefaultTags(m, tags(d))
+		planned := make([]interface{}, len(merged))
+		for i, tag := range merged {
+			planned[i] = tag
+		}
+
+		if d.Get("tags_all").(*schema.Set).Equal(schema.NewSet(schema.HashString, planned)) {
+			return nil
+		}
+
+		return d.SetNew("tags_all", merged)
+	}
+}
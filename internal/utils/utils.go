@@ -4,9 +4,9 @@ package utils
 // func ValidateNameSize
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"regexp"
 	"sort"
 	"strconv"
@@ -15,6 +15,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -35,7 +36,15 @@ func ValidateName(v interface{}, _ string) (ws []string, es []error) {
 	return warns, errs
 }
 
-// ValidateCNIName is a function to check if the cni name is valid
+// knownCNIs are the CNI plugins the Civo API supported when the provider was released
+var knownCNIs = []string{"cilium", "flannel"}
+
+// cniName matches the format of the name of a CNI plugin
+var cniName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// ValidateCNIName is a function to check if the cni name is valid. The Civo API doesn't list the CNI plugins
+// it supports, so plugins other than the known ones only raise a warning and are checked by the API when
+// the cluster is created, that way new plugins can be used without a new release of the provider.
 func ValidateCNIName(v interface{}, _ string) (ws []string, es []error) {
 	var errs []error
 	var warns []string
@@ -50,11 +59,18 @@ func ValidateCNIName(v interface{}, _ string) (ws []string, es []error) {
 		return warns, errs
 	}
 
-	if value != "flannel" && value != "cilium" {
-		errs = append(errs, fmt.Errorf("CNI plugin provided isn't valid/supported"))
+	if !cniName.MatchString(value) {
+		errs = append(errs, fmt.Errorf("CNI plugin %q isn't valid, it must only contain lowercase letters, numbers and hyphens", value))
 		return warns, errs
 	}
 
+	for _, cni := range knownCNIs {
+		if value == cni {
+			return warns, errs
+		}
+	}
+	warns = append(warns, fmt.Sprintf("CNI plugin %q isn't one of the known plugins (%s), the Civo API rejects it when creating the cluster if it isn't supported", value, strings.Join(knownCNIs, ", ")))
+
 	return warns, errs
 }
 
@@ -92,22 +108,6 @@ func ResourceCommonParseID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
-// CheckAPPName is a function to check if the app name is valid
-func CheckAPPName(appName string, client *civogo.Client) bool {
-	allAPP, err := client.ListKubernetesMarketplaceApplications()
-	if err != nil {
-		return false
-	}
-
-	for _, v := range allAPP {
-		if strings.Contains(appName, v.Name) {
-			return true
-		}
-	}
-
-	return false
-}
-
 // GetCommaSeparatedAllowedKeys is used by "tfplugindocs" CLI to generate Markdown docs
 func GetCommaSeparatedAllowedKeys(allowedKeys []string) string {
 	res := []string{}
@@ -179,7 +179,7 @@ func InPool(id string, list []civogo.KubernetesClusterPoolConfig) bool {
 type FunctionWithError func() error
 
 // RetryUntilSuccessOrTimeout calls the provided function repeatedly until it returns no error or the timeout has passed.
-func RetryUntilSuccessOrTimeout(fn FunctionWithError, interval time.Duration, timeout time.Duration) error {
+func RetryUntilSuccessOrTimeout(ctx context.Context, fn FunctionWithError, interval time.Duration, timeout time.Duration) error {
 	start := time.Now()
 	for {
 		err := fn()
@@ -187,7 +187,7 @@ func RetryUntilSuccessOrTimeout(fn FunctionWithError, interval time.Duration, ti
 			if time.Since(start) > timeout {
 				return errors.New("timeout reached")
 			}
-			log.Printf("[INFO] Retrying after error: %s", err)
+			tflog.Debug(ctx, fmt.Sprintf("retrying after error: %s", err))
 			time.Sleep(interval)
 			continue
 		}
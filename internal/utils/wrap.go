@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// crudFunc is the signature shared by the create, read, update and delete functions of a resource
+type crudFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// wrapCRUD replaces every CRUD function defined in the resource with the result of wrap
+func wrapCRUD(r *schema.Resource, wrap func(operation string, fn crudFunc) crudFunc) {
+	if r.CreateContext != nil {
+		r.CreateContext = wrap("create", r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrap("read", r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrap("update", r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrap("delete", r.DeleteContext)
+	}
+}
@@ -2,11 +2,16 @@ package main
 
 import (
 	"github.com/civo/terraform-provider-civo/civo"
+	"github.com/civo/terraform-provider-civo/civo/functions"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: civo.Provider,
+		GRPCProviderFunc: func() tfprotov5.ProviderServer {
+			return functions.NewProviderServer(schema.NewGRPCProviderServer(civo.Provider()))
+		},
 	})
 }